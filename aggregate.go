@@ -0,0 +1,192 @@
+package hbase
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// countFilter sends only the first key of every row.
+const countFilter = "FirstKeyOnlyFilter() AND KeyOnlyFilter()"
+
+// CountRows counts the rows of scan, the whole table for a nil scan, scanning
+// the regions of the table in parallel. Unless scan has a FilterString the
+// rows are scanned with FirstKeyOnlyFilter and KeyOnlyFilter, so the gateway
+// only sends their first key. A FilterString is kept as is, the filters
+// skipping cells could otherwise drop rows they match. A reversed scan counts
+// the same rows as forward.
+//
+// c is called from several goroutines and must be safe for concurrent use,
+// such as a Client over a pool.TPoolClient, which a Client over a single
+// transport is not.
+func CountRows(ctx context.Context, c Hbase, tableName []byte, scan *TScan) (int64, error) {
+	s := NewTScan()
+	if scan != nil {
+		*s = *scan
+	}
+	s.BatchSize = nil
+	if len(s.FilterString) == 0 {
+		s.FilterString = []byte(countFilter)
+	}
+	scans, err := regionScans(ctx, c, tableName, s)
+	if err != nil {
+		return 0, err
+	}
+	counts := make([]int64, len(scans))
+	err = eachScan(ctx, scans, func(ctx context.Context, i int, scan *TScan) error {
+		return eachRow(ctx, c, tableName, scan, func(*TRowResult_) error {
+			counts[i]++
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	for _, c := range counts {
+		n += c
+	}
+	return n, nil
+}
+
+// CountRows runs CountRows on the client.
+func (p *Client) CountRows(ctx context.Context, tableName []byte, scan *TScan) (int64, error) {
+	return CountRows(ctx, p, tableName, scan)
+}
+
+// Aggregation is the result of Aggregate over the cells of a column.
+type Aggregation struct {
+	// the number of cells, Sum, Min and Max are 0 without cells
+	Count         int64
+	Sum, Min, Max int64
+}
+
+func (a *Aggregation) add(v int64) {
+	if a.Count == 0 || v < a.Min {
+		a.Min = v
+	}
+	if a.Count == 0 || v > a.Max {
+		a.Max = v
+	}
+	a.Count++
+	a.Sum += v
+}
+
+func (a *Aggregation) merge(b *Aggregation) {
+	if b.Count == 0 {
+		return
+	}
+	if a.Count == 0 || b.Min < a.Min {
+		a.Min = b.Min
+	}
+	if a.Count == 0 || b.Max > a.Max {
+		a.Max = b.Max
+	}
+	a.Count += b.Count
+	a.Sum += b.Sum
+}
+
+// Mean returns Sum / Count, 0 without cells.
+func (a *Aggregation) Mean() float64 {
+	if a.Count == 0 {
+		return 0
+	}
+	return float64(a.Sum) / float64(a.Count)
+}
+
+// ErrNotNumeric is wrapped by the errors of decoders.
+var ErrNotNumeric = errors.New("hbase: cell value is not a number")
+
+// DecodeInt64 decodes the 8 byte big endian values of AtomicIncrement and
+// Bytes.toBytes(long).
+func DecodeInt64(value []byte) (int64, error) {
+	if len(value) != 8 {
+		return 0, fmt.Errorf("%w: %d bytes", ErrNotNumeric, len(value))
+	}
+	return int64(binary.BigEndian.Uint64(value)), nil
+}
+
+// DecodeDecimal decodes decimal text such as "-42".
+func DecodeDecimal(value []byte) (int64, error) {
+	v, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrNotNumeric, value)
+	}
+	return v, nil
+}
+
+// Aggregate computes the count, sum, min and max of the cells of column,
+// "family:qualifier", in the rows of scan, the whole table for a nil scan,
+// scanning the regions of the table in parallel. decode reads the values,
+// DecodeInt64 if nil, and its errors end the aggregation. As for CountRows,
+// c must be safe for concurrent use.
+func Aggregate(ctx context.Context, c Hbase, tableName, column []byte, scan *TScan, decode func([]byte) (int64, error)) (*Aggregation, error) {
+	if decode == nil {
+		decode = DecodeInt64
+	}
+	s := NewTScan()
+	if scan != nil {
+		*s = *scan
+	}
+	s.Columns, s.BatchSize = [][]byte{column}, nil
+	scans, err := regionScans(ctx, c, tableName, s)
+	if err != nil {
+		return nil, err
+	}
+	aggs := make([]Aggregation, len(scans))
+	err = eachScan(ctx, scans, func(ctx context.Context, i int, scan *TScan) error {
+		return eachRow(ctx, c, tableName, scan, func(r *TRowResult_) error {
+			cell := r.Columns[string(column)]
+			if cell == nil {
+				for _, sc := range r.SortedColumns {
+					if string(sc.ColumnName) == string(column) {
+						cell = sc.Cell
+					}
+				}
+			}
+			if cell == nil {
+				return nil
+			}
+			v, err := decode(cell.Value)
+			if err != nil {
+				return fmt.Errorf("row %q: %w", r.Row, err)
+			}
+			aggs[i].add(v)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	a := &Aggregation{}
+	for i := range aggs {
+		a.merge(&aggs[i])
+	}
+	return a, nil
+}
+
+// Aggregate runs Aggregate on the client.
+func (p *Client) Aggregate(ctx context.Context, tableName, column []byte, scan *TScan, decode func([]byte) (int64, error)) (*Aggregation, error) {
+	return Aggregate(ctx, p, tableName, column, scan, decode)
+}
+
+// eachRow calls fn with the rows of scan.
+func eachRow(ctx context.Context, c Hbase, tableName []byte, scan *TScan, fn func(*TRowResult_) error) error {
+	s := NewScanner(c, tableName, scan, nil)
+	defer s.Close(ctx)
+	for {
+		r, err := s.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = fn(r); err != nil {
+			return err
+		}
+	}
+}
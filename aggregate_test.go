@@ -0,0 +1,133 @@
+package hbase_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"sync"
+	"testing"
+)
+
+// scanRecorder records the scans opened on the server.
+type scanRecorder struct {
+	*memhbase.Server
+	mu    sync.Mutex
+	scans []*hbase.TScan
+}
+
+func (s *scanRecorder) ScannerOpenWithScan(ctx context.Context, tableName []byte, scan *hbase.TScan, attributes map[string][]byte) (hbase.ScannerID, error) {
+	s.mu.Lock()
+	s.scans = append(s.scans, scan)
+	s.mu.Unlock()
+	return s.Server.ScannerOpenWithScan(ctx, tableName, scan, attributes)
+}
+
+func newAggregateServer(t *testing.T) *scanRecorder {
+	ctx := context.Background()
+	e := newExpiring(t, 0)
+	for i := 0; i < 30; i++ {
+		row := []byte(fmt.Sprintf("r%02d", i))
+		n, d := hbase.NewMutation(), hbase.NewMutation()
+		n.Column, n.Value = []byte("f:n"), make([]byte, 8)
+		binary.BigEndian.PutUint64(n.Value, uint64(i-10))
+		d.Column, d.Value = []byte("f:d"), []byte(fmt.Sprint(i*2))
+		if i%3 == 0 {
+			d.Value = []byte("x")
+		}
+		if err := e.MutateRow(ctx, []byte("t"), row, []*hbase.Mutation{n, d}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.Split([]byte("t"), []byte("r10"), []byte("r15"), []byte("r20")); err != nil {
+		t.Fatal(err)
+	}
+	return &scanRecorder{Server: e.Server}
+}
+
+func TestCountRows(t *testing.T) {
+	ctx := context.Background()
+	s := newAggregateServer(t)
+	n, err := hbase.CountRows(ctx, s, []byte("t"), nil)
+	if err != nil || n != 30 {
+		t.Fatalf("CountRows = %d, %v", n, err)
+	}
+	if len(s.scans) != 4 {
+		t.Fatalf("%d scans for 4 regions", len(s.scans))
+	}
+	for _, scan := range s.scans {
+		if string(scan.FilterString) != "FirstKeyOnlyFilter() AND KeyOnlyFilter()" {
+			t.Fatalf("scan filter %q", scan.FilterString)
+		}
+	}
+
+	s.scans = nil
+	n, err = hbase.CountRows(ctx, s, []byte("t"), &hbase.TScan{StartRow: []byte("r12"), StopRow: []byte("r17")})
+	if err != nil || n != 5 {
+		t.Fatalf("CountRows [r12, r17) = %d, %v", n, err)
+	}
+	if len(s.scans) != 2 || string(s.scans[0].StartRow) == string(s.scans[1].StartRow) {
+		t.Fatalf("scans %v, wanted [r12, r15) and [r15, r17)", s.scans)
+	}
+
+	scan := &hbase.TScan{FilterString: []byte("PrefixFilter('r2')")}
+	if n, err = hbase.CountRows(ctx, s, []byte("t"), scan); err != nil || n != 10 {
+		t.Fatalf("CountRows with a filter = %d, %v", n, err)
+	}
+	reversed := true
+	for _, c := range []struct {
+		start, stop string
+		want        int64
+	}{
+		// the rows of [r12, r17) forward, over 2 regions
+		{"r16", "r11", 5},
+		{"r17", "r11", 6},
+		{"", "r11", 18},
+		{"r11", "", 12},
+		{"", "", 30},
+	} {
+		scan := &hbase.TScan{StartRow: []byte(c.start), StopRow: []byte(c.stop), Reversed: &reversed}
+		if n, err = hbase.CountRows(ctx, s, []byte("t"), scan); err != nil || n != c.want {
+			t.Fatalf("CountRows reversed from %q to %q = %d, %v, wanted %d", c.start, c.stop, n, err, c.want)
+		}
+		if !*scan.Reversed {
+			t.Fatal("scan modified")
+		}
+	}
+	if _, err = hbase.CountRows(ctx, s, []byte("missing"), nil); err == nil {
+		t.Fatal("wanted an error for a missing table")
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	ctx := context.Background()
+	s := newAggregateServer(t)
+	a, err := hbase.Aggregate(ctx, s, []byte("t"), []byte("f:n"), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Count != 30 || a.Sum != 135 || a.Min != -10 || a.Max != 19 || a.Mean() != 4.5 {
+		t.Fatalf("Aggregate = %+v", a)
+	}
+
+	a, err = hbase.Aggregate(ctx, s, []byte("t"), []byte("f:n"), &hbase.TScan{StartRow: []byte("r40")}, nil)
+	if err != nil || *a != (hbase.Aggregation{}) || a.Mean() != 0 {
+		t.Fatalf("Aggregate of no rows = %+v, %v", a, err)
+	}
+
+	reversed := true
+	a, err = hbase.Aggregate(ctx, s, []byte("t"), []byte("f:n"), &hbase.TScan{StartRow: []byte("r29"), StopRow: []byte("r19"), Reversed: &reversed}, nil)
+	if err != nil || a.Count != 10 || a.Sum != 145 || a.Min != 10 || a.Max != 19 {
+		t.Fatalf("Aggregate reversed = %+v, %v", a, err)
+	}
+
+	if _, err = hbase.Aggregate(ctx, s, []byte("t"), []byte("f:d"), nil, hbase.DecodeDecimal); !errors.Is(err, hbase.ErrNotNumeric) {
+		t.Fatalf("wanted ErrNotNumeric, got %v", err)
+	}
+	a, err = hbase.Aggregate(ctx, s, []byte("t"), []byte("f:d"), &hbase.TScan{StartRow: []byte("r01"), StopRow: []byte("r03")}, hbase.DecodeDecimal)
+	if err != nil || a.Count != 2 || a.Sum != 6 || a.Min != 2 || a.Max != 4 {
+		t.Fatalf("Aggregate decimal = %+v, %v", a, err)
+	}
+}
@@ -0,0 +1,306 @@
+package hbase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// DefaultBatchParallelism is the number of region sub-batches a Batcher
+// sends at once.
+var DefaultBatchParallelism = 8
+
+// BatchError is returned by a Batcher for a batch some rows of which failed,
+// the others succeeded.
+type BatchError struct {
+	// Errors has an error per row of the batch, nil for the rows which
+	// succeeded.
+	Errors []error
+}
+
+// Failed returns the indexes of the rows which failed.
+func (e *BatchError) Failed() []int {
+	var failed []int
+	for i, err := range e.Errors {
+		if err != nil {
+			failed = append(failed, i)
+		}
+	}
+	return failed
+}
+
+func (e *BatchError) Error() string {
+	failed := e.Failed()
+	if len(failed) == 0 {
+		return "hbase: batch failed"
+	}
+	return fmt.Sprintf("hbase: %d of %d rows failed, row %d: %v", len(failed), len(e.Errors), failed[0], e.Errors[failed[0]])
+}
+
+// Unwrap returns the errors of the failed rows.
+func (e *BatchError) Unwrap() []error {
+	var errs []error
+	for _, err := range e.Errors {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Batcher splits the rows of MutateRows, GetRows and IncrementRows by region
+// and sends a call per region, so a large batch spreads over the region
+// servers and an unavailable region only fails its own rows.
+type Batcher struct {
+	c           Hbase
+	regions     *RegionCache
+	parallelism int
+	bisect      bool
+}
+
+// NewBatcher returns a Batcher sending calls to c and finding the regions of
+// rows in regions, a cache of c which is never reloaded if nil. The calls of
+// a batch are sent from several goroutines, so c must be safe for concurrent
+// use, such as a Client over a pool.TPoolClient, which a Client over a single
+// transport is not.
+func NewBatcher(c Hbase, regions *RegionCache) *Batcher {
+	if regions == nil {
+		regions = NewRegionCache(c, 0)
+	}
+	return &Batcher{c: c, regions: regions, parallelism: DefaultBatchParallelism}
+}
+
+// SetBisect sets whether the items of a call the gateway failed with an
+// IOError or IllegalArgument are sent again in two halves, the regions of
+// which are looked up again, until the items failing are alone. One bad row
+// then only fails itself. A call failing with a region error, see
+// IsRegionError, is not bisected: bisect or not, its items are sent again
+// once, grouped by the reloaded regions. A call failing on the connection is
+// not retried, as it may have been applied.
+//
+// MutateRows may apply some rows of a call it fails, a retry puts them
+// again. IncrementRows may increment them again.
+func (b *Batcher) SetBisect(bisect bool) {
+	b.bisect = bisect
+}
+
+// SetParallelism sets the number of calls sent at once.
+func (b *Batcher) SetParallelism(parallelism int) {
+	if parallelism > 0 {
+		b.parallelism = parallelism
+	}
+}
+
+// ItemResult is the outcome of an item of a batch.
+type ItemResult struct {
+	// Err is the error of the last call with the item, nil if it succeeded.
+	Err error
+	// Retries is the number of times the item was sent again.
+	Retries int
+}
+
+// batchError returns a *BatchError with the errors of results, nil if all
+// items succeeded.
+func batchError(results []ItemResult) error {
+	var errs []error
+	for i, r := range results {
+		if r.Err != nil {
+			if errs == nil {
+				errs = make([]error, len(results))
+			}
+			errs[i] = r.Err
+		}
+	}
+	if errs == nil {
+		return nil
+	}
+	return &BatchError{Errors: errs}
+}
+
+// part is items of a batch to send to one table.
+type part struct {
+	table []byte
+	items []int
+	// resent is set once the items were sent again after a region error
+	resent bool
+}
+
+// group splits the items of p by region, keeping their order. Rows out of
+// the cached regions, which are stale then, are sent together and left to
+// the gateway.
+func (b *Batcher) group(ctx context.Context, p part, row func(i int) []byte) ([]part, error) {
+	regions, err := b.regions.Regions(ctx, p.table)
+	if err != nil {
+		return nil, err
+	}
+	var parts []part
+	index := make(map[*TRegionInfo]int)
+	for _, i := range p.items {
+		r := regionFor(regions, row(i))
+		g, ok := index[r]
+		if !ok {
+			g = len(parts)
+			index[r] = g
+			parts = append(parts, part{table: p.table, resent: p.resent})
+		}
+		parts[g].items = append(parts[g].items, i)
+	}
+	return parts, nil
+}
+
+// execute sends the n items of a batch with a call per table and region,
+// parallelism at once, sending the items of a call failed by a region error
+// again once and bisecting the other failed calls if set.
+func (b *Batcher) execute(ctx context.Context, n int, table, row func(i int) []byte, call func(ctx context.Context, p part) error) []ItemResult {
+	results := make([]ItemResult, n)
+	var pending []part
+	tables := make(map[string]int)
+	for i := 0; i < n; i++ {
+		t, ok := tables[string(table(i))]
+		if !ok {
+			t = len(pending)
+			tables[string(table(i))] = t
+			pending = append(pending, part{table: table(i)})
+		}
+		pending[t].items = append(pending[t].items, i)
+	}
+
+	for len(pending) > 0 {
+		var parts []part
+		for _, p := range pending {
+			g, err := b.group(ctx, p, row)
+			if err != nil {
+				for _, i := range p.items {
+					results[i].Err = err
+				}
+				continue
+			}
+			parts = append(parts, g...)
+		}
+		pending = nil
+
+		var (
+			wg  sync.WaitGroup
+			mu  sync.Mutex
+			sem = make(chan struct{}, b.parallelism)
+		)
+		for _, p := range parts {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(p part) {
+				defer func() { <-sem; wg.Done() }()
+				err := b.regions.Check(p.table, call(ctx, p))
+				mu.Lock()
+				defer mu.Unlock()
+				var again []part
+				switch {
+				case err == nil:
+				case IsRegionError(err):
+					// splitting would not help, Check dropped the regions
+					// and the items are grouped again by the current ones
+					if !p.resent {
+						again = []part{{p.table, p.items, true}}
+					}
+				case b.bisect && len(p.items) > 1 && bisectable(err):
+					h := len(p.items) / 2
+					again = []part{{p.table, p.items[:h], p.resent}, {p.table, p.items[h:], p.resent}}
+				}
+				if again != nil {
+					pending = append(pending, again...)
+					for _, i := range p.items {
+						results[i].Retries++
+					}
+					return
+				}
+				for _, i := range p.items {
+					results[i].Err = err
+				}
+			}(p)
+		}
+		wg.Wait()
+	}
+	return results
+}
+
+// bisectable reports whether err is the gateway rejecting the items of a
+// call, which does not fail the connection. Region errors are not, they fail
+// every item of the region.
+func bisectable(err error) bool {
+	var (
+		ioe *IOError
+		ia  *IllegalArgument
+	)
+	return errors.As(err, &ioe) || errors.As(err, &ia)
+}
+
+// MutateRows applies rowBatches with a MutateRows call per region. If some
+// calls fail it returns a *BatchError with the errors by row batch, the
+// others are applied.
+func (b *Batcher) MutateRows(ctx context.Context, tableName []byte, rowBatches []*BatchMutation, attributes map[string][]byte) error {
+	return batchError(b.MutateRowsResults(ctx, tableName, rowBatches, attributes))
+}
+
+// MutateRowsResults is MutateRows returning the result of every row batch.
+func (b *Batcher) MutateRowsResults(ctx context.Context, tableName []byte, rowBatches []*BatchMutation, attributes map[string][]byte) []ItemResult {
+	return b.execute(ctx, len(rowBatches), func(int) []byte { return tableName }, func(i int) []byte { return rowBatches[i].Row }, func(ctx context.Context, p part) error {
+		batches := make([]*BatchMutation, len(p.items))
+		for j, i := range p.items {
+			batches[j] = rowBatches[i]
+		}
+		return b.c.MutateRows(ctx, tableName, batches, attributes)
+	})
+}
+
+// GetRows gets rows with a GetRowsWithColumns call per region, with the
+// given columns or all of them. The result has a row result per row, nil for
+// the rows not found or failed. If some calls fail it returns a *BatchError
+// with the errors by row, along with the rows of the others.
+func (b *Batcher) GetRows(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, attributes map[string][]byte) ([]*TRowResult_, error) {
+	r, results := b.GetRowsResults(ctx, tableName, rows, columns, attributes)
+	return r, batchError(results)
+}
+
+// GetRowsResults is GetRows returning the result of every row.
+func (b *Batcher) GetRowsResults(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, attributes map[string][]byte) ([]*TRowResult_, []ItemResult) {
+	found := make([]*TRowResult_, len(rows))
+	results := b.execute(ctx, len(rows), func(int) []byte { return tableName }, func(i int) []byte { return rows[i] }, func(ctx context.Context, p part) error {
+		keys := make([][]byte, len(p.items))
+		for j, i := range p.items {
+			keys[j] = rows[i]
+		}
+		rs, err := b.c.GetRowsWithColumns(ctx, tableName, keys, columns, attributes)
+		if err != nil {
+			return err
+		}
+		byRow := make(map[string]*TRowResult_, len(rs))
+		for _, r := range rs {
+			byRow[string(r.Row)] = r
+		}
+		// the parts are disjoint, no lock needed
+		for _, i := range p.items {
+			found[i] = byRow[string(rows[i])]
+		}
+		return nil
+	})
+	return found, results
+}
+
+// IncrementRows applies increments with an IncrementRows call per table and
+// region. If some calls fail it returns a *BatchError with the errors by
+// increment, the others are applied.
+func (b *Batcher) IncrementRows(ctx context.Context, increments []*TIncrement) error {
+	return batchError(b.IncrementRowsResults(ctx, increments))
+}
+
+// IncrementRowsResults is IncrementRows returning the result of every
+// increment.
+func (b *Batcher) IncrementRowsResults(ctx context.Context, increments []*TIncrement) []ItemResult {
+	return b.execute(ctx, len(increments), func(i int) []byte { return increments[i].Table }, func(i int) []byte { return increments[i].Row }, func(ctx context.Context, p part) error {
+		incs := make([]*TIncrement, len(p.items))
+		for j, i := range p.items {
+			incs[j] = increments[i]
+		}
+		return b.c.IncrementRows(ctx, incs)
+	})
+}
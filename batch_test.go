@@ -0,0 +1,316 @@
+package hbase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// unavailable fails the batch calls with a row starting with down, and
+// records the calls.
+type unavailable struct {
+	*regionCounter
+	down string
+
+	calls, running, maxRunning int32
+	mu                         sync.Mutex
+	batches                    [][]string
+}
+
+func (u *unavailable) enter(rows [][]byte) error {
+	atomic.AddInt32(&u.calls, 1)
+	n := atomic.AddInt32(&u.running, 1)
+	for {
+		m := atomic.LoadInt32(&u.maxRunning)
+		if n <= m || atomic.CompareAndSwapInt32(&u.maxRunning, m, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	atomic.AddInt32(&u.running, -1)
+	var batch []string
+	for _, r := range rows {
+		batch = append(batch, string(r))
+	}
+	u.mu.Lock()
+	u.batches = append(u.batches, batch)
+	u.mu.Unlock()
+	for _, r := range batch {
+		if u.down != "" && strings.HasPrefix(r, u.down) {
+			return &hbase.IOError{Message: "org.apache.hadoop.hbase.NotServingRegionException: Region t," + u.down + ",2 is not online"}
+		}
+	}
+	return nil
+}
+
+func (u *unavailable) MutateRows(ctx context.Context, tableName []byte, rowBatches []*hbase.BatchMutation, attributes map[string][]byte) error {
+	var rows [][]byte
+	for _, b := range rowBatches {
+		rows = append(rows, b.Row)
+	}
+	if err := u.enter(rows); err != nil {
+		return err
+	}
+	return u.regionCounter.MutateRows(ctx, tableName, rowBatches, attributes)
+}
+
+func (u *unavailable) GetRowsWithColumns(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, attributes map[string][]byte) ([]*hbase.TRowResult_, error) {
+	if err := u.enter(rows); err != nil {
+		return nil, err
+	}
+	return u.regionCounter.GetRowsWithColumns(ctx, tableName, rows, columns, attributes)
+}
+
+func newUnavailable(t *testing.T, down string) *unavailable {
+	s := &regionCounter{Server: newExpiring(t, 0).Server}
+	if err := s.Split([]byte("t"), []byte("d"), []byte("h"), []byte("m"), []byte("q")); err != nil {
+		t.Fatal(err)
+	}
+	return &unavailable{regionCounter: s, down: down}
+}
+
+func batchOf(rows ...string) []*hbase.BatchMutation {
+	var b []*hbase.BatchMutation
+	for _, r := range rows {
+		m := hbase.NewMutation()
+		m.Column, m.Value = []byte("f:a"), []byte("v"+r)
+		b = append(b, &hbase.BatchMutation{Row: []byte(r), Mutations: []*hbase.Mutation{m}})
+	}
+	return b
+}
+
+func TestBatcher_MutateRows(t *testing.T) {
+	ctx := context.Background()
+	u := newUnavailable(t, "m")
+	b := hbase.NewBatcher(u, nil)
+	b.SetParallelism(2)
+
+	rows := []string{"a", "z", "e", "m1", "b", "n", "r", "i", "m2"}
+	err := b.MutateRows(ctx, []byte("t"), batchOf(rows...), nil)
+	var be *hbase.BatchError
+	if !errors.As(err, &be) {
+		t.Fatalf("wanted a BatchError, got %v", err)
+	}
+	if f := be.Failed(); len(f) != 3 || f[0] != 3 || f[1] != 5 || f[2] != 8 {
+		t.Fatalf("failed rows %v, wanted those of region [m, q)", f)
+	}
+	if !hbase.IsRegionError(err) {
+		t.Fatalf("%v does not unwrap to the region error", err)
+	}
+	// the region [m, q) is sent again once after the region error
+	if n := atomic.LoadInt32(&u.calls); n != 6 {
+		t.Fatalf("%d calls for 5 regions and a retry", n)
+	}
+	if m := atomic.LoadInt32(&u.maxRunning); m > 2 {
+		t.Fatalf("%d calls at once, parallelism 2", m)
+	}
+	want := map[string]bool{"a,b": true, "e": true, "i": true, "m1,n,m2": true, "z,r": true}
+	for _, batch := range u.batches {
+		if !want[strings.Join(batch, ",")] {
+			t.Fatalf("batch %q, wanted the rows of a region in order", batch)
+		}
+	}
+	for i, res := range b.MutateRowsResults(ctx, []byte("t"), batchOf("m3", "a"), nil) {
+		if want := 1 - i; res.Retries != want {
+			t.Fatalf("item %d: %+v, wanted %d retries", i, res, want)
+		}
+	}
+
+	results, err := hbase.NewBatcher(u.regionCounter.Server, nil).GetRows(ctx, []byte("t"), [][]byte{[]byte("a"), []byte("m1"), []byte("r")}, nil, nil)
+	if err != nil || results[0] == nil || results[1] != nil || string(results[2].Columns["f:a"].Value) != "vr" {
+		t.Fatalf("rows of the failed region were applied: %v, %v", results, err)
+	}
+	// the region error dropped the cached regions
+	if err := b.MutateRows(ctx, []byte("t"), batchOf("a"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&u.loads); n != 5 {
+		t.Fatalf("%d region loads, wanted a reload after each region error", n)
+	}
+}
+
+func TestBatcher_GetRows(t *testing.T) {
+	ctx := context.Background()
+	u := newUnavailable(t, "")
+	b := hbase.NewBatcher(u, nil)
+	if err := b.MutateRows(ctx, []byte("t"), batchOf("a", "e", "m1", "z"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	u.down = "m"
+	rows := [][]byte{[]byte("z"), []byte("m1"), []byte("missing"), []byte("a"), []byte("e"), []byte("a")}
+	results, err := b.GetRows(ctx, []byte("t"), rows, [][]byte{[]byte("f:a")}, nil)
+	var be *hbase.BatchError
+	if !errors.As(err, &be) || len(be.Failed()) != 2 || be.Errors[1] == nil || be.Errors[2] == nil {
+		t.Fatalf("wanted the rows of region [m, q) to fail, got %v", err)
+	}
+	for i, want := range []string{"vz", "", "", "va", "ve", "va"} {
+		if want == "" {
+			if results[i] != nil {
+				t.Errorf("row %q: %v", rows[i], results[i])
+			}
+		} else if results[i] == nil || string(results[i].Columns["f:a"].Value) != want {
+			t.Errorf("row %q: %v, wanted %s", rows[i], results[i], want)
+		}
+	}
+}
+
+// rejecting fails the MutateRows and IncrementRows calls with the row "bad"
+// with an IOError, and those with the row "drop" on the connection.
+type rejecting struct {
+	*regionCounter
+	calls int32
+}
+
+func (r *rejecting) check(rows [][]byte) error {
+	atomic.AddInt32(&r.calls, 1)
+	for _, row := range rows {
+		switch string(row) {
+		case "bad":
+			return &hbase.IOError{Message: "java.lang.IllegalArgumentException: No columns to insert"}
+		case "drop":
+			return thrift.NewTTransportException(thrift.END_OF_FILE, "EOF")
+		}
+	}
+	return nil
+}
+
+func (r *rejecting) MutateRows(ctx context.Context, tableName []byte, rowBatches []*hbase.BatchMutation, attributes map[string][]byte) error {
+	var rows [][]byte
+	for _, b := range rowBatches {
+		rows = append(rows, b.Row)
+	}
+	if err := r.check(rows); err != nil {
+		return err
+	}
+	return r.regionCounter.MutateRows(ctx, tableName, rowBatches, attributes)
+}
+
+func (r *rejecting) IncrementRows(ctx context.Context, increments []*hbase.TIncrement) error {
+	var rows [][]byte
+	for _, inc := range increments {
+		rows = append(rows, inc.Row)
+	}
+	if err := r.check(rows); err != nil {
+		return err
+	}
+	return r.regionCounter.IncrementRows(ctx, increments)
+}
+
+func TestBatcher_Bisect(t *testing.T) {
+	ctx := context.Background()
+	r := &rejecting{regionCounter: &regionCounter{Server: newExpiring(t, 0).Server}}
+	b := hbase.NewBatcher(r, nil)
+	b.SetBisect(true)
+
+	var rows []string
+	for i := 0; i < 16; i++ {
+		rows = append(rows, fmt.Sprintf("r%02d", i))
+	}
+	rows[5] = "bad"
+	results := b.MutateRowsResults(ctx, []byte("t"), batchOf(rows...), nil)
+	for i, res := range results {
+		if i == 5 {
+			if _, ok := res.Err.(*hbase.IOError); !ok || res.Retries != 4 {
+				t.Fatalf("bad row: %+v, wanted an IOError after 4 retries", res)
+			}
+			continue
+		}
+		if res.Err != nil || res.Retries == 0 {
+			t.Fatalf("row %s: %+v", rows[i], res)
+		}
+	}
+	// 1 call, then 2, 2, 2 and 2 for the halves holding the bad row
+	if n := atomic.LoadInt32(&r.calls); n != 9 {
+		t.Fatalf("%d calls", n)
+	}
+	got, _ := hbase.NewBatcher(r.Server, nil).GetRows(ctx, []byte("t"), [][]byte{[]byte("r15"), []byte("bad")}, nil, nil)
+	if got[0] == nil || got[1] != nil {
+		t.Fatalf("rows %v, wanted all but the bad one applied", got)
+	}
+
+	// a connection failure is not retried
+	atomic.StoreInt32(&r.calls, 0)
+	results = b.MutateRowsResults(ctx, []byte("t"), batchOf("a", "drop", "c"), nil)
+	if n := atomic.LoadInt32(&r.calls); n != 1 || results[0].Err == nil || results[0].Retries != 0 {
+		t.Fatalf("%d calls, %+v", n, results)
+	}
+
+	// without bisection the whole call fails
+	b.SetBisect(false)
+	err := b.MutateRows(ctx, []byte("t"), batchOf("x1", "bad", "x2"), nil)
+	var be *hbase.BatchError
+	if !errors.As(err, &be) || len(be.Failed()) != 3 {
+		t.Fatalf("wanted 3 failed rows, got %v", err)
+	}
+}
+
+func TestBatcher_BisectRegionError(t *testing.T) {
+	ctx := context.Background()
+	u := newUnavailable(t, "m")
+	b := hbase.NewBatcher(u, nil)
+	b.SetBisect(true)
+
+	var rows []string
+	for i := 0; i < 64; i++ {
+		rows = append(rows, fmt.Sprintf("m%02d", i))
+	}
+	rows = append(rows, "a")
+	results := b.MutateRowsResults(ctx, []byte("t"), batchOf(rows...), nil)
+	for i, res := range results[:64] {
+		if !hbase.IsRegionError(res.Err) || res.Retries != 1 {
+			t.Fatalf("row %s: %+v, wanted the region error after one retry", rows[i], res)
+		}
+	}
+	if res := results[64]; res.Err != nil || res.Retries != 0 {
+		t.Fatalf("row a: %+v", res)
+	}
+	// the region [m, q) is sent twice, not bisected, and [, d) once
+	if n := atomic.LoadInt32(&u.calls); n != 3 {
+		t.Fatalf("%d calls", n)
+	}
+	if n := atomic.LoadInt32(&u.loads); n != 2 {
+		t.Fatalf("%d region loads, wanted a reload before the retry", n)
+	}
+}
+
+func TestBatcher_IncrementRows(t *testing.T) {
+	ctx := context.Background()
+	r := &rejecting{regionCounter: &regionCounter{Server: newExpiring(t, 0).Server}}
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = []byte("f:")
+	if err := r.CreateTable(ctx, []byte("u"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Split([]byte("t"), []byte("m")); err != nil {
+		t.Fatal(err)
+	}
+	b := hbase.NewBatcher(r, nil)
+	b.SetBisect(true)
+	inc := func(table, row string) *hbase.TIncrement {
+		return &hbase.TIncrement{Table: []byte(table), Row: []byte(row), Column: []byte("f:n"), Ammount: 2}
+	}
+	incs := []*hbase.TIncrement{inc("t", "a"), inc("u", "a"), inc("t", "z"), inc("t", "bad"), inc("u", "b")}
+	err := b.IncrementRows(ctx, incs)
+	var be *hbase.BatchError
+	if !errors.As(err, &be) || len(be.Failed()) != 1 || be.Errors[3] == nil {
+		t.Fatalf("wanted the bad increment to fail, got %v", err)
+	}
+	// a call per table and region
+	if n := atomic.LoadInt32(&r.calls); n != 5 {
+		t.Fatalf("%d calls", n)
+	}
+	for _, tr := range []struct{ table, row string }{{"t", "a"}, {"u", "a"}, {"t", "z"}, {"u", "b"}} {
+		cells, err := r.Get(ctx, []byte(tr.table), []byte(tr.row), []byte("f:n"), nil)
+		if err != nil || len(cells) != 1 || cells[0].Value[7] != 2 {
+			t.Fatalf("%s/%s = %v, %v", tr.table, tr.row, cells, err)
+		}
+	}
+}
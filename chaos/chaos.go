@@ -0,0 +1,204 @@
+// Package chaos injects faults into the calls of a Client, with a
+// thrift.TClient wrapper, or into a gateway, with an hbase.Hbase handler
+// wrapper, to test retries and scanners against failures at given rates
+// without a flaky cluster.
+package chaos
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// DefaultIOErrorMessages are IOError messages as the gateway reports them.
+var DefaultIOErrorMessages = []string{
+	"org.apache.hadoop.hbase.NotServingRegionException: Region t,,1700000000000.0f9e1cbf3b1b4e7a8a5d2c1e0b9a8f7e. is not online on rs1.example.com,16020,1700000000000",
+	"org.apache.hadoop.hbase.RegionTooBusyException: Over memstore limit=512.0M, regionName=0f9e1cbf3b1b4e7a8a5d2c1e0b9a8f7e, server=rs1.example.com,16020,1700000000000",
+	"org.apache.hadoop.hbase.CallQueueTooBigException: Call queue is full on rs1.example.com,16020,1700000000000, too many items queued ?",
+	"org.apache.hadoop.hbase.client.RetriesExhaustedException: Failed after attempts=36, exceptions:\njava.net.SocketTimeoutException: callTimeout=60000, callDuration=60107",
+}
+
+// Fault is the faults injected into the calls of a method, each at a rate
+// between 0 (never) and 1 (every call).
+type Fault struct {
+	// Latency is added to a call at LatencyRate.
+	Latency     time.Duration
+	LatencyRate float64
+	// TransportErrorRate fails calls before they are sent, with a
+	// TTransportException from the TClient and a dropped connection from the
+	// handler.
+	TransportErrorRate float64
+	// IOErrorRate fails calls with an IOError instead of running them.
+	IOErrorRate float64
+	// DropRate drops the connection after a call ran, so the caller does not
+	// know whether it succeeded.
+	DropRate float64
+	// PartialPageRate returns a part of the rows of a scannerGetList page,
+	// the others come with the next calls. hbase.Scanner only ends on an
+	// empty page, so it still gets every row.
+	PartialPageRate float64
+}
+
+// Config is the faults by Thrift method name, e.g. "scannerGetList".
+type Config struct {
+	// Default applies to the methods not in Methods.
+	Default Fault
+	Methods map[string]Fault
+	// IOErrorMessages are picked from for IOErrors, DefaultIOErrorMessages if
+	// empty.
+	IOErrorMessages []string
+	// Seed makes the faults injected reproducible.
+	Seed int64
+}
+
+type injector struct {
+	cfg Config
+
+	mu      sync.Mutex
+	rnd     *rand.Rand
+	pending map[hbase.ScannerID][]*hbase.TRowResult_
+}
+
+func newInjector(cfg *Config) *injector {
+	in := &injector{pending: make(map[hbase.ScannerID][]*hbase.TRowResult_)}
+	if cfg != nil {
+		in.cfg = *cfg
+	}
+	if len(in.cfg.IOErrorMessages) == 0 {
+		in.cfg.IOErrorMessages = DefaultIOErrorMessages
+	}
+	in.rnd = rand.New(rand.NewSource(in.cfg.Seed))
+	return in
+}
+
+func (in *injector) fault(method string) Fault {
+	if f, ok := in.cfg.Methods[method]; ok {
+		return f
+	}
+	return in.cfg.Default
+}
+
+func (in *injector) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return in.rnd.Float64() < rate
+}
+
+// delay sleeps for the latency of f, unless ctx is done first.
+func (in *injector) delay(ctx context.Context, f Fault) error {
+	if f.Latency <= 0 || !in.roll(f.LatencyRate) {
+		return nil
+	}
+	t := time.NewTimer(f.Latency)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (in *injector) ioError() *hbase.IOError {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return &hbase.IOError{Message: in.cfg.IOErrorMessages[in.rnd.Intn(len(in.cfg.IOErrorMessages))]}
+}
+
+// scannerGetList serves the rows of id held back from a previous page
+// first, or fetches a page, and holds back the end of it at the rate of f.
+func (in *injector) scannerGetList(f Fault, id hbase.ScannerID, nbRows int32, fetch func() ([]*hbase.TRowResult_, error)) ([]*hbase.TRowResult_, error) {
+	in.mu.Lock()
+	rows := in.pending[id]
+	if len(rows) > int(nbRows) && nbRows > 0 {
+		in.pending[id], rows = rows[nbRows:], rows[:nbRows]
+	} else {
+		delete(in.pending, id)
+	}
+	in.mu.Unlock()
+	if len(rows) == 0 {
+		var err error
+		if rows, err = fetch(); err != nil {
+			return rows, err
+		}
+	}
+	if len(rows) < 2 || !in.roll(f.PartialPageRate) {
+		return rows, nil
+	}
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	n := 1 + in.rnd.Intn(len(rows)-1)
+	in.pending[id] = append(append([]*hbase.TRowResult_(nil), rows[n:]...), in.pending[id]...)
+	return rows[:n], nil
+}
+
+func (in *injector) forget(id hbase.ScannerID) {
+	in.mu.Lock()
+	delete(in.pending, id)
+	in.mu.Unlock()
+}
+
+// Client is a thrift.TClient injecting faults into the calls of another one.
+type Client struct {
+	c  thrift.TClient
+	in *injector
+}
+
+// NewClient returns a Client injecting the faults of cfg into the calls of c.
+func NewClient(c thrift.TClient, cfg *Config) *Client {
+	return &Client{c: c, in: newInjector(cfg)}
+}
+
+var ioErrorType = reflect.TypeOf((*hbase.IOError)(nil))
+
+// setIOError sets the IOError of a result, if the method declares one.
+func setIOError(result thrift.TStruct, e *hbase.IOError) bool {
+	v := reflect.ValueOf(result)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	f := v.Elem().FieldByName("Io")
+	if !f.IsValid() || f.Type() != ioErrorType {
+		return false
+	}
+	f.Set(reflect.ValueOf(e))
+	return true
+}
+
+func (c *Client) Call(ctx context.Context, method string, args, result thrift.TStruct) (meta thrift.ResponseMeta, err error) {
+	f := c.in.fault(method)
+	if err := c.in.delay(ctx, f); err != nil {
+		return meta, thrift.NewTTransportExceptionFromError(err)
+	}
+	if c.in.roll(f.TransportErrorRate) {
+		return meta, thrift.NewTTransportException(thrift.TIMED_OUT, "chaos: i/o timeout")
+	}
+	if c.in.roll(f.IOErrorRate) && setIOError(result, c.in.ioError()) {
+		return meta, nil
+	}
+
+	switch a := args.(type) {
+	case *hbase.ScannerGetListArgs:
+		r := result.(*hbase.ScannerGetListResult)
+		r.Success, err = c.in.scannerGetList(f, a.ID, a.NbRows, func() ([]*hbase.TRowResult_, error) {
+			meta, err = c.c.Call(ctx, method, args, result)
+			return r.Success, err
+		})
+	case *hbase.ScannerCloseArgs:
+		c.in.forget(a.ID)
+		meta, err = c.c.Call(ctx, method, args, result)
+	default:
+		meta, err = c.c.Call(ctx, method, args, result)
+	}
+	if err == nil && c.in.roll(f.DropRate) {
+		return meta, thrift.NewTTransportException(thrift.END_OF_FILE, "chaos: connection dropped")
+	}
+	return meta, err
+}
@@ -0,0 +1,169 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/He11oLx/hbase/pool"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTable(t *testing.T, rows int) *memhbase.Server {
+	s := memhbase.NewTable(t, "t", "f")
+	s.PutRows(t, "t", "f:a", rows)
+	return s
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	s := newTable(t, 20)
+	c := hbase.NewClient(NewClient(memhbase.NewLoopback(s), &Config{
+		Methods: map[string]Fault{
+			"get":            {IOErrorRate: 1},
+			"getTableNames":  {TransportErrorRate: 1},
+			"mutateRow":      {DropRate: 1},
+			"scannerGetList": {PartialPageRate: 1},
+			"getRow":         {Latency: time.Minute, LatencyRate: 1},
+		},
+	}))
+
+	if _, err := c.Get(ctx, []byte("t"), []byte("r00"), []byte("f:a"), nil); err == nil {
+		t.Fatal("wanted IOError")
+	} else if e, ok := err.(*hbase.IOError); !ok || !strings.HasPrefix(e.Message, "org.apache.hadoop.hbase.") {
+		t.Fatalf("wanted IOError, got %T %v", err, err)
+	}
+	if _, err := c.GetTableNames(ctx); err == nil {
+		t.Fatal("wanted a transport error")
+	} else if _, ok := err.(thrift.TTransportException); !ok {
+		t.Fatalf("wanted TTransportException, got %T %v", err, err)
+	}
+
+	m := hbase.NewMutation()
+	m.Column, m.Value = []byte("f:a"), []byte("dropped")
+	if err := c.MutateRow(ctx, []byte("t"), []byte("r00"), []*hbase.Mutation{m}, nil); err == nil {
+		t.Fatal("wanted a dropped connection")
+	}
+	if cells, _ := s.Get(ctx, []byte("t"), []byte("r00"), []byte("f:a"), nil); len(cells) != 1 || string(cells[0].Value) != "dropped" {
+		t.Fatalf("the mutation did not run before the drop: %v", cells)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetRow(ctx2, []byte("t"), []byte("r00"), nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("wanted DeadlineExceeded, got %v", err)
+	}
+
+	id, err := c.ScannerOpenWithScan(ctx, []byte("t"), hbase.NewTScan(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rows []string
+	for {
+		page, err := c.ScannerGetList(ctx, id, 8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		if len(page) >= 8 {
+			t.Fatalf("page of %d rows is not partial", len(page))
+		}
+		for _, r := range page {
+			rows = append(rows, string(r.Row))
+		}
+	}
+	c.ScannerClose(ctx, id)
+	if len(rows) != 20 || rows[0] != "r00" || rows[19] != "r19" {
+		t.Fatalf("rows = %v", rows)
+	}
+	for i := 1; i < len(rows); i++ {
+		if rows[i-1] >= rows[i] {
+			t.Fatalf("rows out of order: %v", rows)
+		}
+	}
+}
+
+func TestClient_Scanner(t *testing.T) {
+	ctx := context.Background()
+	s := newTable(t, 50)
+	c := hbase.NewClient(NewClient(memhbase.NewLoopback(s), &Config{
+		Methods: map[string]Fault{"scannerGetList": {PartialPageRate: 1}},
+	}))
+	caching := int32(8)
+	scan := hbase.NewTScan()
+	scan.Caching = &caching
+	rows, err := hbase.ScanAll(ctx, c, []byte("t"), scan, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 50 {
+		t.Fatalf("%d rows scanned of 50", len(rows))
+	}
+	for i, r := range rows {
+		if string(r.Row) != fmt.Sprintf("r%02d", i) {
+			t.Fatalf("row %d is %q", i, r.Row)
+		}
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	ctx := context.Background()
+	s := newTable(t, 1)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := memhbase.Listen(hbase.NewProcessor(NewHandler(s, &Config{
+		Methods: map[string]Fault{
+			"get":           {IOErrorRate: 1},
+			"getTableNames": {DropRate: 1},
+		},
+	})), ln, nil)
+	defer l.Close()
+	host, port, _ := net.SplitHostPort(l.Addr)
+
+	f := thrift.NewTBinaryProtocolFactoryConf(nil)
+	p, err := pool.NewTPoolClient(host, port, f, f, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Destroy()
+	c := hbase.NewClient(p)
+
+	if _, err := c.Get(ctx, []byte("t"), []byte("r00"), []byte("f:a"), nil); err == nil {
+		t.Fatal("wanted IOError")
+	} else if _, ok := err.(*hbase.IOError); !ok {
+		t.Fatalf("wanted IOError, got %T %v", err, err)
+	}
+	if _, err := c.GetTableNames(ctx); err == nil {
+		t.Fatal("wanted a dropped connection")
+	} else if _, ok := err.(thrift.TTransportException); !ok {
+		t.Fatalf("wanted TTransportException, got %T %v", err, err)
+	}
+	// the pool recovers with a new connection
+	cells, err := c.GetRow(ctx, []byte("t"), []byte("r00"), nil)
+	if err != nil || len(cells) != 1 {
+		t.Fatalf("GetRow = %v, %v", cells, err)
+	}
+}
+
+func TestConfig_Seed(t *testing.T) {
+	run := func() []bool {
+		in := newInjector(&Config{Seed: 42})
+		var rolls []bool
+		for i := 0; i < 32; i++ {
+			rolls = append(rolls, in.roll(0.5))
+		}
+		return rolls
+	}
+	if a, b := run(), run(); fmt.Sprint(a) != fmt.Sprint(b) {
+		t.Fatalf("rolls differ for the same seed:\n%v\n%v", a, b)
+	}
+}
@@ -0,0 +1,351 @@
+package chaos
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+type handler struct {
+	h  hbase.Hbase
+	in *injector
+}
+
+// NewHandler returns a handler injecting the faults of cfg into the calls of
+// h, for a processor standing in for a gateway. Transport errors and dropped
+// connections close the connection of the call without a reply.
+func NewHandler(h hbase.Hbase, cfg *Config) hbase.Hbase {
+	return &handler{h: h, in: newInjector(cfg)}
+}
+
+func (h *handler) before(ctx context.Context, method string) error {
+	f := h.in.fault(method)
+	if err := h.in.delay(ctx, f); err != nil {
+		return err
+	}
+	if h.in.roll(f.TransportErrorRate) {
+		return thrift.ErrAbandonRequest
+	}
+	if h.in.roll(f.IOErrorRate) {
+		return h.in.ioError()
+	}
+	return nil
+}
+
+func (h *handler) after(method string, err error) error {
+	if err == nil && h.in.roll(h.in.fault(method).DropRate) {
+		return thrift.ErrAbandonRequest
+	}
+	return err
+}
+
+func (h *handler) EnableTable(ctx context.Context, tableName []byte) (err error) {
+	if err = h.before(ctx, "enableTable"); err == nil {
+		err = h.h.EnableTable(ctx, tableName)
+	}
+	return h.after("enableTable", err)
+}
+
+func (h *handler) DisableTable(ctx context.Context, tableName []byte) (err error) {
+	if err = h.before(ctx, "disableTable"); err == nil {
+		err = h.h.DisableTable(ctx, tableName)
+	}
+	return h.after("disableTable", err)
+}
+
+func (h *handler) IsTableEnabled(ctx context.Context, tableName []byte) (r bool, err error) {
+	if err = h.before(ctx, "isTableEnabled"); err == nil {
+		r, err = h.h.IsTableEnabled(ctx, tableName)
+	}
+	return r, h.after("isTableEnabled", err)
+}
+
+func (h *handler) Compact(ctx context.Context, tableNameOrRegionName []byte) (err error) {
+	if err = h.before(ctx, "compact"); err == nil {
+		err = h.h.Compact(ctx, tableNameOrRegionName)
+	}
+	return h.after("compact", err)
+}
+
+func (h *handler) MajorCompact(ctx context.Context, tableNameOrRegionName []byte) (err error) {
+	if err = h.before(ctx, "majorCompact"); err == nil {
+		err = h.h.MajorCompact(ctx, tableNameOrRegionName)
+	}
+	return h.after("majorCompact", err)
+}
+
+func (h *handler) GetTableNames(ctx context.Context) (r [][]byte, err error) {
+	if err = h.before(ctx, "getTableNames"); err == nil {
+		r, err = h.h.GetTableNames(ctx)
+	}
+	return r, h.after("getTableNames", err)
+}
+
+func (h *handler) GetColumnDescriptors(ctx context.Context, tableName []byte) (r map[string]*hbase.ColumnDescriptor, err error) {
+	if err = h.before(ctx, "getColumnDescriptors"); err == nil {
+		r, err = h.h.GetColumnDescriptors(ctx, tableName)
+	}
+	return r, h.after("getColumnDescriptors", err)
+}
+
+func (h *handler) GetTableRegions(ctx context.Context, tableName []byte) (r []*hbase.TRegionInfo, err error) {
+	if err = h.before(ctx, "getTableRegions"); err == nil {
+		r, err = h.h.GetTableRegions(ctx, tableName)
+	}
+	return r, h.after("getTableRegions", err)
+}
+
+func (h *handler) CreateTable(ctx context.Context, tableName []byte, columnFamilies []*hbase.ColumnDescriptor) (err error) {
+	if err = h.before(ctx, "createTable"); err == nil {
+		err = h.h.CreateTable(ctx, tableName, columnFamilies)
+	}
+	return h.after("createTable", err)
+}
+
+func (h *handler) DeleteTable(ctx context.Context, tableName []byte) (err error) {
+	if err = h.before(ctx, "deleteTable"); err == nil {
+		err = h.h.DeleteTable(ctx, tableName)
+	}
+	return h.after("deleteTable", err)
+}
+
+func (h *handler) Get(ctx context.Context, tableName []byte, row []byte, column []byte, attributes map[string][]byte) (r []*hbase.TCell, err error) {
+	if err = h.before(ctx, "get"); err == nil {
+		r, err = h.h.Get(ctx, tableName, row, column, attributes)
+	}
+	return r, h.after("get", err)
+}
+
+func (h *handler) GetVer(ctx context.Context, tableName []byte, row []byte, column []byte, numVersions int32, attributes map[string][]byte) (r []*hbase.TCell, err error) {
+	if err = h.before(ctx, "getVer"); err == nil {
+		r, err = h.h.GetVer(ctx, tableName, row, column, numVersions, attributes)
+	}
+	return r, h.after("getVer", err)
+}
+
+func (h *handler) GetVerTs(ctx context.Context, tableName []byte, row []byte, column []byte, timestamp int64, numVersions int32, attributes map[string][]byte) (r []*hbase.TCell, err error) {
+	if err = h.before(ctx, "getVerTs"); err == nil {
+		r, err = h.h.GetVerTs(ctx, tableName, row, column, timestamp, numVersions, attributes)
+	}
+	return r, h.after("getVerTs", err)
+}
+
+func (h *handler) GetRow(ctx context.Context, tableName []byte, row []byte, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "getRow"); err == nil {
+		r, err = h.h.GetRow(ctx, tableName, row, attributes)
+	}
+	return r, h.after("getRow", err)
+}
+
+func (h *handler) GetRowWithColumns(ctx context.Context, tableName []byte, row []byte, columns [][]byte, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "getRowWithColumns"); err == nil {
+		r, err = h.h.GetRowWithColumns(ctx, tableName, row, columns, attributes)
+	}
+	return r, h.after("getRowWithColumns", err)
+}
+
+func (h *handler) GetRowTs(ctx context.Context, tableName []byte, row []byte, timestamp int64, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "getRowTs"); err == nil {
+		r, err = h.h.GetRowTs(ctx, tableName, row, timestamp, attributes)
+	}
+	return r, h.after("getRowTs", err)
+}
+
+func (h *handler) GetRowWithColumnsTs(ctx context.Context, tableName []byte, row []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "getRowWithColumnsTs"); err == nil {
+		r, err = h.h.GetRowWithColumnsTs(ctx, tableName, row, columns, timestamp, attributes)
+	}
+	return r, h.after("getRowWithColumnsTs", err)
+}
+
+func (h *handler) GetRows(ctx context.Context, tableName []byte, rows [][]byte, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "getRows"); err == nil {
+		r, err = h.h.GetRows(ctx, tableName, rows, attributes)
+	}
+	return r, h.after("getRows", err)
+}
+
+func (h *handler) GetRowsWithColumns(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "getRowsWithColumns"); err == nil {
+		r, err = h.h.GetRowsWithColumns(ctx, tableName, rows, columns, attributes)
+	}
+	return r, h.after("getRowsWithColumns", err)
+}
+
+func (h *handler) GetRowsTs(ctx context.Context, tableName []byte, rows [][]byte, timestamp int64, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "getRowsTs"); err == nil {
+		r, err = h.h.GetRowsTs(ctx, tableName, rows, timestamp, attributes)
+	}
+	return r, h.after("getRowsTs", err)
+}
+
+func (h *handler) GetRowsWithColumnsTs(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "getRowsWithColumnsTs"); err == nil {
+		r, err = h.h.GetRowsWithColumnsTs(ctx, tableName, rows, columns, timestamp, attributes)
+	}
+	return r, h.after("getRowsWithColumnsTs", err)
+}
+
+func (h *handler) MutateRow(ctx context.Context, tableName []byte, row []byte, mutations []*hbase.Mutation, attributes map[string][]byte) (err error) {
+	if err = h.before(ctx, "mutateRow"); err == nil {
+		err = h.h.MutateRow(ctx, tableName, row, mutations, attributes)
+	}
+	return h.after("mutateRow", err)
+}
+
+func (h *handler) MutateRowTs(ctx context.Context, tableName []byte, row []byte, mutations []*hbase.Mutation, timestamp int64, attributes map[string][]byte) (err error) {
+	if err = h.before(ctx, "mutateRowTs"); err == nil {
+		err = h.h.MutateRowTs(ctx, tableName, row, mutations, timestamp, attributes)
+	}
+	return h.after("mutateRowTs", err)
+}
+
+func (h *handler) MutateRows(ctx context.Context, tableName []byte, rowBatches []*hbase.BatchMutation, attributes map[string][]byte) (err error) {
+	if err = h.before(ctx, "mutateRows"); err == nil {
+		err = h.h.MutateRows(ctx, tableName, rowBatches, attributes)
+	}
+	return h.after("mutateRows", err)
+}
+
+func (h *handler) MutateRowsTs(ctx context.Context, tableName []byte, rowBatches []*hbase.BatchMutation, timestamp int64, attributes map[string][]byte) (err error) {
+	if err = h.before(ctx, "mutateRowsTs"); err == nil {
+		err = h.h.MutateRowsTs(ctx, tableName, rowBatches, timestamp, attributes)
+	}
+	return h.after("mutateRowsTs", err)
+}
+
+func (h *handler) AtomicIncrement(ctx context.Context, tableName []byte, row []byte, column []byte, value int64) (r int64, err error) {
+	if err = h.before(ctx, "atomicIncrement"); err == nil {
+		r, err = h.h.AtomicIncrement(ctx, tableName, row, column, value)
+	}
+	return r, h.after("atomicIncrement", err)
+}
+
+func (h *handler) DeleteAll(ctx context.Context, tableName []byte, row []byte, column []byte, attributes map[string][]byte) (err error) {
+	if err = h.before(ctx, "deleteAll"); err == nil {
+		err = h.h.DeleteAll(ctx, tableName, row, column, attributes)
+	}
+	return h.after("deleteAll", err)
+}
+
+func (h *handler) DeleteAllTs(ctx context.Context, tableName []byte, row []byte, column []byte, timestamp int64, attributes map[string][]byte) (err error) {
+	if err = h.before(ctx, "deleteAllTs"); err == nil {
+		err = h.h.DeleteAllTs(ctx, tableName, row, column, timestamp, attributes)
+	}
+	return h.after("deleteAllTs", err)
+}
+
+func (h *handler) DeleteAllRow(ctx context.Context, tableName []byte, row []byte, attributes map[string][]byte) (err error) {
+	if err = h.before(ctx, "deleteAllRow"); err == nil {
+		err = h.h.DeleteAllRow(ctx, tableName, row, attributes)
+	}
+	return h.after("deleteAllRow", err)
+}
+
+func (h *handler) Increment(ctx context.Context, increment *hbase.TIncrement) (err error) {
+	if err = h.before(ctx, "increment"); err == nil {
+		err = h.h.Increment(ctx, increment)
+	}
+	return h.after("increment", err)
+}
+
+func (h *handler) IncrementRows(ctx context.Context, increments []*hbase.TIncrement) (err error) {
+	if err = h.before(ctx, "incrementRows"); err == nil {
+		err = h.h.IncrementRows(ctx, increments)
+	}
+	return h.after("incrementRows", err)
+}
+
+func (h *handler) DeleteAllRowTs(ctx context.Context, tableName []byte, row []byte, timestamp int64, attributes map[string][]byte) (err error) {
+	if err = h.before(ctx, "deleteAllRowTs"); err == nil {
+		err = h.h.DeleteAllRowTs(ctx, tableName, row, timestamp, attributes)
+	}
+	return h.after("deleteAllRowTs", err)
+}
+
+func (h *handler) ScannerOpenWithScan(ctx context.Context, tableName []byte, scan *hbase.TScan, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	if err = h.before(ctx, "scannerOpenWithScan"); err == nil {
+		r, err = h.h.ScannerOpenWithScan(ctx, tableName, scan, attributes)
+	}
+	return r, h.after("scannerOpenWithScan", err)
+}
+
+func (h *handler) ScannerOpen(ctx context.Context, tableName []byte, startRow []byte, columns [][]byte, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	if err = h.before(ctx, "scannerOpen"); err == nil {
+		r, err = h.h.ScannerOpen(ctx, tableName, startRow, columns, attributes)
+	}
+	return r, h.after("scannerOpen", err)
+}
+
+func (h *handler) ScannerOpenWithStop(ctx context.Context, tableName []byte, startRow []byte, stopRow []byte, columns [][]byte, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	if err = h.before(ctx, "scannerOpenWithStop"); err == nil {
+		r, err = h.h.ScannerOpenWithStop(ctx, tableName, startRow, stopRow, columns, attributes)
+	}
+	return r, h.after("scannerOpenWithStop", err)
+}
+
+func (h *handler) ScannerOpenWithPrefix(ctx context.Context, tableName []byte, startAndPrefix []byte, columns [][]byte, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	if err = h.before(ctx, "scannerOpenWithPrefix"); err == nil {
+		r, err = h.h.ScannerOpenWithPrefix(ctx, tableName, startAndPrefix, columns, attributes)
+	}
+	return r, h.after("scannerOpenWithPrefix", err)
+}
+
+func (h *handler) ScannerOpenTs(ctx context.Context, tableName []byte, startRow []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	if err = h.before(ctx, "scannerOpenTs"); err == nil {
+		r, err = h.h.ScannerOpenTs(ctx, tableName, startRow, columns, timestamp, attributes)
+	}
+	return r, h.after("scannerOpenTs", err)
+}
+
+func (h *handler) ScannerOpenWithStopTs(ctx context.Context, tableName []byte, startRow []byte, stopRow []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	if err = h.before(ctx, "scannerOpenWithStopTs"); err == nil {
+		r, err = h.h.ScannerOpenWithStopTs(ctx, tableName, startRow, stopRow, columns, timestamp, attributes)
+	}
+	return r, h.after("scannerOpenWithStopTs", err)
+}
+
+func (h *handler) ScannerGet(ctx context.Context, id hbase.ScannerID) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "scannerGet"); err == nil {
+		r, err = h.h.ScannerGet(ctx, id)
+	}
+	return r, h.after("scannerGet", err)
+}
+
+func (h *handler) ScannerGetList(ctx context.Context, id hbase.ScannerID, nbRows int32) (r []*hbase.TRowResult_, err error) {
+	if err = h.before(ctx, "scannerGetList"); err == nil {
+		r, err = h.in.scannerGetList(h.in.fault("scannerGetList"), id, nbRows, func() ([]*hbase.TRowResult_, error) {
+			return h.h.ScannerGetList(ctx, id, nbRows)
+		})
+	}
+	return r, h.after("scannerGetList", err)
+}
+
+func (h *handler) ScannerClose(ctx context.Context, id hbase.ScannerID) (err error) {
+	h.in.forget(id)
+	if err = h.before(ctx, "scannerClose"); err == nil {
+		err = h.h.ScannerClose(ctx, id)
+	}
+	return h.after("scannerClose", err)
+}
+
+func (h *handler) GetRegionInfo(ctx context.Context, row []byte) (r *hbase.TRegionInfo, err error) {
+	if err = h.before(ctx, "getRegionInfo"); err == nil {
+		r, err = h.h.GetRegionInfo(ctx, row)
+	}
+	return r, h.after("getRegionInfo", err)
+}
+
+func (h *handler) Append(ctx context.Context, append *hbase.TAppend) (r []*hbase.TCell, err error) {
+	if err = h.before(ctx, "append"); err == nil {
+		r, err = h.h.Append(ctx, append)
+	}
+	return r, h.after("append", err)
+}
+
+func (h *handler) CheckAndPut(ctx context.Context, tableName []byte, row []byte, column []byte, value []byte, mput *hbase.Mutation, attributes map[string][]byte) (r bool, err error) {
+	if err = h.before(ctx, "checkAndPut"); err == nil {
+		r, err = h.h.CheckAndPut(ctx, tableName, row, column, value, mput, attributes)
+	}
+	return r, h.after("checkAndPut", err)
+}
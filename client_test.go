@@ -3,7 +3,7 @@ package hbase
 import (
 	"context"
 	"fmt"
-	"git.apache.org/thrift.git/lib/go/thrift"
+	"github.com/apache/thrift/lib/go/thrift"
 	"net"
 	"os"
 	"testing"
@@ -38,7 +38,8 @@ func TestNewClient(t *testing.T) {
 	if err := trans.Open(); err != nil {
 		t.Error("Error opening socket to ", host, ":", port, " ", err)
 	}
-	ctx, _ := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	tbs, _ := client.GetTableNames(ctx)
 	for _, tb := range tbs {
@@ -0,0 +1,125 @@
+package hbase
+
+import (
+	"context"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net/http"
+	"reflect"
+)
+
+// DoAsAttribute is the call attribute carrying the user set by WithDoAs.
+const DoAsAttribute = "doAs"
+
+type (
+	doAsKey       struct{}
+	attributesKey struct{}
+)
+
+// WithAttributes returns a context adding attributes to every call of a
+// Client taking attributes, e.g. a request id or visibility labels. They are
+// merged with those of ctx, the new ones taking precedence. The attributes
+// passed to a call explicitly take precedence over those of its context, then
+// the user of WithDoAs.
+func WithAttributes(ctx context.Context, attributes map[string][]byte) context.Context {
+	merged := make(map[string][]byte)
+	for k, v := range Attributes(ctx) {
+		merged[k] = v
+	}
+	for k, v := range attributes {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, attributesKey{}, merged)
+}
+
+// Attributes returns the attributes set by WithAttributes. The map must not
+// be modified.
+func Attributes(ctx context.Context) map[string][]byte {
+	attributes, _ := ctx.Value(attributesKey{}).(map[string][]byte)
+	return attributes
+}
+
+// WithDoAs returns a context making the calls of a Client with it act as user
+// on a gateway allowing impersonation (hbase.thrift.support.proxyuser), so
+// the ACLs of user apply instead of those of the authenticated one. The user
+// is sent as the doAs attribute of calls taking attributes and, over HTTP,
+// also as the doAs query parameter, which the gateway checks for every call.
+func WithDoAs(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, doAsKey{}, user)
+}
+
+// DoAs returns the user set by WithDoAs.
+func DoAs(ctx context.Context) (string, bool) {
+	user, ok := ctx.Value(doAsKey{}).(string)
+	return user, ok
+}
+
+// contextClient adds the values of the context of a call to its arguments.
+// Every Client wraps its thrift.TClient with it.
+type contextClient struct {
+	c thrift.TClient
+}
+
+func withContext(c thrift.TClient) thrift.TClient {
+	return contextClient{c: c}
+}
+
+func (c contextClient) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	attributes := Attributes(ctx)
+	if user, ok := DoAs(ctx); ok {
+		attributes = make(map[string][]byte, len(attributes)+1)
+		for k, v := range Attributes(ctx) {
+			attributes[k] = v
+		}
+		attributes[DoAsAttribute] = []byte(user)
+	}
+	if len(attributes) != 0 {
+		mergeAttributes(args, attributes)
+	}
+	return c.c.Call(ctx, method, args, result)
+}
+
+var attributesType = reflect.TypeOf(map[string][]byte(nil))
+
+// mergeAttributes adds attributes to the Attributes field of the arguments of
+// a call, if any. The attributes passed explicitly take precedence. The map
+// of the caller is not modified.
+func mergeAttributes(args thrift.TStruct, attributes map[string][]byte) {
+	v := reflect.ValueOf(args)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	f := v.Elem().FieldByName("Attributes")
+	if !f.IsValid() || f.Type() != attributesType {
+		return
+	}
+	explicit := f.Interface().(map[string][]byte)
+	merged := make(map[string][]byte, len(explicit)+len(attributes))
+	for k, v := range attributes {
+		merged[k] = v
+	}
+	for k, v := range explicit {
+		merged[k] = v
+	}
+	f.Set(reflect.ValueOf(merged))
+}
+
+// doAsTransport adds the user set by WithDoAs to the URL of a request.
+type doAsTransport struct {
+	base http.RoundTripper
+}
+
+func (t doAsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	user, ok := DoAs(req.Context())
+	if !ok {
+		return base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	q := req.URL.Query()
+	q.Set("doAs", user)
+	req.URL.RawQuery = q.Encode()
+	return base.RoundTrip(req)
+}
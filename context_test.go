@@ -0,0 +1,115 @@
+package hbase_test
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net/http"
+	"testing"
+)
+
+// recorder is a thrift.TClient keeping the arguments of the last call.
+type recorder struct {
+	args thrift.TStruct
+}
+
+func (r *recorder) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	r.args = args
+	return thrift.ResponseMeta{}, nil
+}
+
+func TestWithDoAs(t *testing.T) {
+	r := &recorder{}
+	c := hbase.NewClient(r)
+	ctx := hbase.WithDoAs(context.Background(), "alice")
+
+	c.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), nil)
+	if got := string(r.args.(*hbase.GetArgs).Attributes[hbase.DoAsAttribute]); got != "alice" {
+		t.Fatalf("doAs = %q", got)
+	}
+
+	explicit := map[string][]byte{"k": []byte("v")}
+	c.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), explicit)
+	if a := r.args.(*hbase.GetArgs).Attributes; string(a["k"]) != "v" || string(a[hbase.DoAsAttribute]) != "alice" {
+		t.Fatalf("attributes = %q", a)
+	}
+	if len(explicit) != 1 {
+		t.Fatalf("the attributes of the caller were modified: %q", explicit)
+	}
+
+	c.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), map[string][]byte{hbase.DoAsAttribute: []byte("bob")})
+	if got := string(r.args.(*hbase.GetArgs).Attributes[hbase.DoAsAttribute]); got != "bob" {
+		t.Fatalf("explicit doAs = %q", got)
+	}
+
+	c.Get(context.Background(), []byte("t"), []byte("r"), []byte("f:a"), nil)
+	if a := r.args.(*hbase.GetArgs).Attributes; a != nil {
+		t.Fatalf("attributes without doAs = %q", a)
+	}
+}
+
+// attributeRecorder keeps the attributes of the last GetRow call.
+type attributeRecorder struct {
+	*memhbase.Server
+	attributes map[string][]byte
+}
+
+func (r *attributeRecorder) GetRow(ctx context.Context, tableName, row []byte, attributes map[string][]byte) ([]*hbase.TRowResult_, error) {
+	r.attributes = attributes
+	return r.Server.GetRow(ctx, tableName, row, attributes)
+}
+
+func TestWithDoAs_HTTP(t *testing.T) {
+	var doAs []string
+	handler := &attributeRecorder{Server: memhbase.New()}
+	s := newHTTPServer(t, handler, func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			doAs = append(doAs, r.URL.Query().Get("doAs"))
+			h.ServeHTTP(w, r)
+		})
+	})
+	c, err := hbase.NewHTTPClient(s.URL+"/?x=1", thrift.NewTBinaryProtocolFactoryConf(nil), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTableNames(hbase.WithDoAs(context.Background(), "alice")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTableNames(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(doAs) != 2 || doAs[0] != "alice" || doAs[1] != "" {
+		t.Fatalf("doAs = %q", doAs)
+	}
+
+	// the attribute is sent along with the query parameter
+	c.GetRow(hbase.WithDoAs(context.Background(), "alice"), []byte("t"), []byte("r"), nil)
+	if len(handler.attributes) != 1 || string(handler.attributes[hbase.DoAsAttribute]) != "alice" {
+		t.Fatalf("attributes over HTTP = %q", handler.attributes)
+	}
+}
+
+func TestWithAttributes(t *testing.T) {
+	r := &recorder{}
+	c := hbase.NewClient(r)
+	ctx := hbase.WithAttributes(context.Background(), map[string][]byte{"request": []byte("1"), "priority": []byte("low")})
+	inner := hbase.WithAttributes(ctx, map[string][]byte{"priority": []byte("high"), hbase.DoAsAttribute: []byte("bob")})
+	inner = hbase.WithDoAs(inner, "alice")
+
+	c.MutateRow(inner, []byte("t"), []byte("r"), nil, map[string][]byte{"request": []byte("2")})
+	a := r.args.(*hbase.MutateRowArgs).Attributes
+	for k, want := range map[string]string{"request": "2", "priority": "high", hbase.DoAsAttribute: "alice"} {
+		if string(a[k]) != want {
+			t.Errorf("%s = %q, want %q", k, a[k], want)
+		}
+	}
+	if got := string(hbase.Attributes(ctx)["priority"]); got != "low" {
+		t.Fatalf("outer priority = %q", got)
+	}
+
+	// calls without attributes are not affected
+	if _, err := c.GetTableNames(inner); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,211 @@
+// Package counter batches counter increments in memory and flushes them with
+// IncrementRows, instead of sending one AtomicIncrement per event.
+package counter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"sync"
+	"time"
+)
+
+var DefaultBatchSize = 1000
+
+var ErrClosed = errors.New("counter: closed")
+
+// Delta is an increment that has not been written yet.
+type Delta struct {
+	Table, Row, Column []byte
+	Amount             int64
+}
+
+// LostError reports the deltas of a failed flush. Increments are not
+// idempotent, so they are not retried: the server may have applied some of
+// them before the error.
+type LostError struct {
+	Deltas []Delta
+	Err    error
+}
+
+func (e *LostError) Error() string {
+	return fmt.Sprintf("counter: lost %d deltas: %s", len(e.Deltas), e.Err)
+}
+
+type key struct {
+	table, row, column string
+}
+
+type Counter struct {
+	c         hbase.Hbase
+	batchSize int
+
+	mu      sync.Mutex
+	pending map[key]int64
+	// the deltas of the flush running, until their batch returns
+	inflight map[key]int64
+	closed   bool
+	onLost   func(*LostError)
+
+	// held by Flush while a batch is sent and by Get while it reads, so Get
+	// sees every batch either applied or in flight
+	sendMu sync.RWMutex
+
+	// serializes flushes so deltas are written in order
+	flushMu sync.Mutex
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// New returns a Counter flushing every interval; interval <= 0 disables the
+// background flush.
+func New(c hbase.Hbase, interval time.Duration) *Counter {
+	p := &Counter{
+		c:         c,
+		batchSize: DefaultBatchSize,
+		pending:   make(map[key]int64),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go p.loop(interval)
+	return p
+}
+
+func (p *Counter) SetBatchSize(n int) {
+	if n > 0 {
+		p.batchSize = n
+	}
+}
+
+// SetOnLost sets the callback for deltas lost by background flushes, which
+// have no caller to return an error to.
+func (p *Counter) SetOnLost(fn func(*LostError)) {
+	p.mu.Lock()
+	p.onLost = fn
+	p.mu.Unlock()
+}
+
+func (p *Counter) loop(interval time.Duration) {
+	defer close(p.done)
+	if interval <= 0 {
+		<-p.stop
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			if err, ok := p.Flush(context.Background()).(*LostError); ok {
+				p.mu.Lock()
+				onLost := p.onLost
+				p.mu.Unlock()
+				if onLost != nil {
+					onLost(err)
+				}
+			}
+		}
+	}
+}
+
+// Add accumulates n for the cell until the next flush.
+func (p *Counter) Add(table, row, column []byte, n int64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return ErrClosed
+	}
+	p.pending[key{string(table), string(row), string(column)}] += n
+	return nil
+}
+
+// Get reads the stored value with AtomicIncrement(..., 0) and adds the delta
+// of this Counter that is not flushed yet, including the one of a flush
+// running. It waits for a batch being sent.
+func (p *Counter) Get(ctx context.Context, table, row, column []byte) (int64, error) {
+	p.sendMu.RLock()
+	defer p.sendMu.RUnlock()
+	v, err := p.c.AtomicIncrement(ctx, table, row, column, 0)
+	if err != nil {
+		return 0, err
+	}
+	k := key{string(table), string(row), string(column)}
+	p.mu.Lock()
+	v += p.pending[k] + p.inflight[k]
+	p.mu.Unlock()
+	return v, nil
+}
+
+// Flush writes the pending deltas. On failure it returns a *LostError with
+// the deltas of the failed batches.
+func (p *Counter) Flush(ctx context.Context) error {
+	p.flushMu.Lock()
+	defer p.flushMu.Unlock()
+
+	p.mu.Lock()
+	pending := p.pending
+	p.pending, p.inflight = make(map[key]int64), pending
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		p.inflight = nil
+		p.mu.Unlock()
+	}()
+
+	batch := make([]*hbase.TIncrement, 0, p.batchSize)
+	var lost *LostError
+	send := func() {
+		p.sendMu.Lock()
+		err := p.c.IncrementRows(ctx, batch)
+		// applied or lost, the deltas already iterated over can go
+		p.mu.Lock()
+		for _, inc := range batch {
+			delete(p.inflight, key{string(inc.Table), string(inc.Row), string(inc.Column)})
+		}
+		p.mu.Unlock()
+		p.sendMu.Unlock()
+		if err != nil {
+			if lost == nil {
+				lost = &LostError{Err: err}
+			}
+			for _, inc := range batch {
+				lost.Deltas = append(lost.Deltas, Delta{Table: inc.Table, Row: inc.Row, Column: inc.Column, Amount: inc.Ammount})
+			}
+		}
+		batch = batch[:0]
+	}
+	for k, n := range pending {
+		if n == 0 {
+			continue
+		}
+		batch = append(batch, &hbase.TIncrement{Table: []byte(k.table), Row: []byte(k.row), Column: []byte(k.column), Ammount: n})
+		if len(batch) >= p.batchSize {
+			send()
+		}
+	}
+	if len(batch) > 0 {
+		send()
+	}
+	if lost != nil {
+		return lost
+	}
+	return nil
+}
+
+// Close stops the background flush and flushes what is left. Add fails with
+// ErrClosed afterwards.
+func (p *Counter) Close(ctx context.Context) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return ErrClosed
+	}
+	p.closed = true
+	p.mu.Unlock()
+	close(p.stop)
+	<-p.done
+	return p.Flush(ctx)
+}
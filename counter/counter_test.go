@@ -0,0 +1,135 @@
+package counter
+
+import (
+	"context"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"testing"
+	"time"
+)
+
+type failing struct {
+	*memhbase.Server
+	fail bool
+}
+
+func (f *failing) IncrementRows(ctx context.Context, increments []*hbase.TIncrement) error {
+	if f.fail {
+		return &hbase.IOError{Message: "region server unavailable"}
+	}
+	return f.Server.IncrementRows(ctx, increments)
+}
+
+func newServer(t *testing.T) *failing {
+	return &failing{Server: memhbase.NewTable(t, "views", "c")}
+}
+
+func TestCounter(t *testing.T) {
+	ctx := context.Background()
+	s := newServer(t)
+	c := New(s, 0)
+	c.SetBatchSize(2)
+	table, col := []byte("views"), []byte("c:n")
+
+	for i := 0; i < 100; i++ {
+		c.Add(table, []byte("page1"), col, 1)
+		c.Add(table, []byte("page2"), col, 2)
+		c.Add(table, []byte("page3"), col, 3)
+	}
+	if v, err := c.Get(ctx, table, []byte("page2"), col); err != nil || v != 200 {
+		t.Fatalf("unflushed Get = %d, %v", v, err)
+	}
+	if err := c.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := s.AtomicIncrement(ctx, table, []byte("page3"), col, 0); v != 300 {
+		t.Fatalf("stored %d, wanted 300", v)
+	}
+
+	s.fail = true
+	c.Add(table, []byte("page1"), col, 5)
+	err := c.Flush(ctx)
+	lost, ok := err.(*LostError)
+	if !ok || len(lost.Deltas) != 1 || lost.Deltas[0].Amount != 5 {
+		t.Fatalf("wanted 1 lost delta, got %v", err)
+	}
+
+	s.fail = false
+	c.Add(table, []byte("page1"), col, 7)
+	if err = c.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := s.AtomicIncrement(ctx, table, []byte("page1"), col, 0); v != 107 {
+		t.Fatalf("stored %d, wanted 107", v)
+	}
+	if err = c.Add(table, []byte("page1"), col, 1); err != ErrClosed {
+		t.Fatalf("wanted ErrClosed, got %v", err)
+	}
+}
+
+func TestCounter_Background(t *testing.T) {
+	ctx := context.Background()
+	s := newServer(t)
+	s.fail = true
+	c := New(s, 10*time.Millisecond)
+	lost := make(chan *LostError, 1)
+	c.SetOnLost(func(e *LostError) { lost <- e })
+	c.Add([]byte("views"), []byte("p"), []byte("c:n"), 1)
+	select {
+	case e := <-lost:
+		if len(e.Deltas) != 1 {
+			t.Fatalf("lost %v", e.Deltas)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("background flush did not run")
+	}
+	c.Close(ctx)
+}
+
+// blocking holds the IncrementRows calls until release is closed.
+type blocking struct {
+	*failing
+	entered, release chan struct{}
+}
+
+func (b *blocking) IncrementRows(ctx context.Context, increments []*hbase.TIncrement) error {
+	b.entered <- struct{}{}
+	<-b.release
+	return b.failing.IncrementRows(ctx, increments)
+}
+
+func TestCounter_GetDuringFlush(t *testing.T) {
+	ctx := context.Background()
+	s := &blocking{failing: newServer(t), entered: make(chan struct{}, 2), release: make(chan struct{})}
+	c := New(s, 0)
+	c.SetBatchSize(1)
+	table, col := []byte("views"), []byte("c:n")
+	c.Add(table, []byte("page1"), col, 7)
+	c.Add(table, []byte("page2"), col, 9)
+
+	flushed := make(chan error, 1)
+	go func() { flushed <- c.Flush(ctx) }()
+	<-s.entered
+	want := map[string]int64{"page1": 7, "page2": 9}
+	got := make(chan error, len(want))
+	for row, n := range want {
+		go func(row string, n int64) {
+			if v, err := c.Get(ctx, table, []byte(row), col); err != nil || v != n {
+				got <- fmt.Errorf("Get(%s) during the flush = %d, %v, wanted %d", row, v, err, n)
+				return
+			}
+			got <- nil
+		}(row, n)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(s.release)
+	if err := <-flushed; err != nil {
+		t.Fatal(err)
+	}
+	for range want {
+		if err := <-got; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
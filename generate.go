@@ -0,0 +1,3 @@
+package hbase
+
+//go:generate go run ./internal/thriftgen -service Hbase -bytes -wrap withContext Hbase.thrift
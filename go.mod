@@ -0,0 +1,5 @@
+module github.com/He11oLx/hbase
+
+go 1.20
+
+require github.com/apache/thrift v0.19.0
@@ -0,0 +1,3 @@
+package hbasemock
+
+//go:generate go run ../internal/mockgen -in ../interface.go -out mock.go
@@ -0,0 +1,217 @@
+// Package hbasemock is a mock of hbase.Hbase for tests of code using a
+// Client, with expectations on the calls, matchers for their arguments and
+// builders of canned results:
+//
+//	m := hbasemock.New(t)
+//	m.On("GetRow", hbasemock.Bytes("t"), hbasemock.Bytes("r1"), hbasemock.Any()).
+//		Return(hbasemock.Rows(hbasemock.Row("r1").Cell("f:a", "v")), nil).Once()
+//	rows, err := m.GetRow(ctx, []byte("t"), []byte("r1"), nil)
+//
+// Methods are named as in hbase.Hbase and their arguments are matched without
+// the context. The methods of Mock are generated from the interface by
+// internal/mockgen.
+package hbasemock
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TestingT is the subset of testing.TB a Mock reports to.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Cleanup(func())
+}
+
+// Mock implements hbase.Hbase. Calls are answered by the first expectation
+// matching them which has calls left, in the order they were set.
+type Mock struct {
+	t TestingT
+
+	mu    sync.Mutex
+	calls []*Call
+	count map[string]int
+}
+
+// New returns a Mock reporting to t, which asserts its expectations when the
+// test ends.
+func New(t TestingT) *Mock {
+	m := &Mock{t: t, count: make(map[string]int)}
+	t.Cleanup(func() { m.AssertExpectations() })
+	return m
+}
+
+// Call is an expectation set by On.
+type Call struct {
+	method  string
+	args    []Matcher
+	results []interface{}
+	run     func(args []interface{})
+	// times is the number of calls expected, -1 for any
+	times int
+	calls int
+}
+
+// On expects a call of method with arguments matching args. Arguments which
+// are not Matchers are compared with Eq. The call is expected once unless
+// Times or AnyTimes says otherwise.
+func (m *Mock) On(method string, args ...interface{}) *Call {
+	c := &Call{method: method, times: 1}
+	for _, a := range args {
+		if mt, ok := a.(Matcher); ok {
+			c.args = append(c.args, mt)
+		} else {
+			c.args = append(c.args, Eq(a))
+		}
+	}
+	m.mu.Lock()
+	m.calls = append(m.calls, c)
+	m.mu.Unlock()
+	return c
+}
+
+// Return sets the results of the call, all of them including the error.
+func (c *Call) Return(results ...interface{}) *Call {
+	c.results = results
+	return c
+}
+
+// Run sets a function called with the arguments of every matching call,
+// without the context, before it returns.
+func (c *Call) Run(fn func(args []interface{})) *Call {
+	c.run = fn
+	return c
+}
+
+// Times expects the call n times.
+func (c *Call) Times(n int) *Call {
+	c.times = n
+	return c
+}
+
+// Once expects the call once.
+func (c *Call) Once() *Call { return c.Times(1) }
+
+// AnyTimes allows the call any number of times, none included.
+func (c *Call) AnyTimes() *Call { return c.Times(-1) }
+
+func (c *Call) String() string {
+	args := make([]string, len(c.args))
+	for i, a := range c.args {
+		args[i] = a.String()
+	}
+	return c.method + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (c *Call) matches(method string, args []interface{}) bool {
+	if c.method != method || len(c.args) != len(args) {
+		return false
+	}
+	for i, a := range c.args {
+		if !a.Matches(args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Calls returns the number of calls of method, expected or not.
+func (m *Mock) Calls(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.count[method]
+}
+
+// AssertExpectations reports the expectations not called as many times as
+// expected.
+func (m *Mock) AssertExpectations() bool {
+	m.t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ok := true
+	for _, c := range m.calls {
+		if c.times >= 0 && c.calls != c.times {
+			m.t.Errorf("hbasemock: %s called %d times, expected %d", c, c.calls, c.times)
+			ok = false
+		}
+	}
+	return ok
+}
+
+// called answers a call of method with args and n results.
+func (m *Mock) called(method string, n int, args ...interface{}) results {
+	m.t.Helper()
+	m.mu.Lock()
+	m.count[method]++
+	var c *Call
+	for _, e := range m.calls {
+		if e.matches(method, args) && (e.times < 0 || e.calls < e.times) {
+			c = e
+			break
+		}
+	}
+	if c == nil {
+		m.mu.Unlock()
+		m.t.Errorf("hbasemock: unexpected call %s(%s)", method, formatArgs(args))
+		return results{m: m, method: method}
+	}
+	c.calls++
+	run := c.run
+	m.mu.Unlock()
+
+	if run != nil {
+		run(args)
+	}
+	if len(c.results) != n {
+		m.t.Errorf("hbasemock: %s returns %d values, %d set by Return", c, n, len(c.results))
+		return results{m: m, method: method}
+	}
+	return results{m: m, method: method, values: c.results}
+}
+
+// results are the values of a call, none for zero values.
+type results struct {
+	m      *Mock
+	method string
+	values []interface{}
+}
+
+// assign sets *p to the i-th result, if it is set and of the type of *p.
+func (r results) assign(i int, p interface{}) {
+	if i >= len(r.values) || r.values[i] == nil {
+		return
+	}
+	v, dst := reflect.ValueOf(r.values[i]), reflect.ValueOf(p).Elem()
+	if !v.Type().AssignableTo(dst.Type()) {
+		r.m.t.Helper()
+		r.m.t.Errorf("hbasemock: result %d of %s is %T, not %s", i, r.method, r.values[i], dst.Type())
+		return
+	}
+	dst.Set(v)
+}
+
+func formatArgs(args []interface{}) string {
+	s := make([]string, len(args))
+	for i, a := range args {
+		s[i] = format(a)
+	}
+	return strings.Join(s, ", ")
+}
+
+// format prints byte slices as strings, which row keys and columns mostly are.
+func format(v interface{}) string {
+	switch v := v.(type) {
+	case []byte:
+		return fmt.Sprintf("%q", v)
+	case [][]byte:
+		s := make([]string, len(v))
+		for i, b := range v {
+			s[i] = fmt.Sprintf("%q", b)
+		}
+		return "[" + strings.Join(s, " ") + "]"
+	}
+	return fmt.Sprintf("%+v", v)
+}
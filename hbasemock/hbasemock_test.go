@@ -0,0 +1,117 @@
+package hbasemock_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/hbasemock"
+	"strings"
+	"testing"
+)
+
+// fakeT records the failures of a Mock instead of failing the test.
+type fakeT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (t *fakeT) Helper() {}
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+func (t *fakeT) Cleanup(f func()) { t.cleanups = append(t.cleanups, f) }
+
+func (t *fakeT) end() {
+	for _, f := range t.cleanups {
+		f()
+	}
+}
+
+func TestMock(t *testing.T) {
+	ctx := context.Background()
+	m := hbasemock.New(t)
+	m.On("GetRow", "t", hbasemock.BytesPrefix("r"), hbasemock.Any()).
+		Return(hbasemock.Rows(hbasemock.Row("r1").Cell("f:a", "v").CellTs("f:b", "w", 7)), nil).Times(2)
+	m.On("GetRow", "t", "x", nil).Return(nil, hbasemock.IOError("no row"))
+	m.On("ScannerOpenWithScan", "t", hbasemock.ScanRange("a", "b"), hbasemock.Any()).Return(hbase.ScannerID(3), nil)
+	m.On("ScannerGetList", hbase.ScannerID(3), 10).Return(hbasemock.Rows(hbasemock.Row("a1").Sorted().Cell("f:b", "2").Cell("f:a", "1")), nil)
+	m.On("ScannerClose", hbasemock.Any()).Return(nil).AnyTimes()
+
+	for i := 0; i < 2; i++ {
+		rows, err := m.GetRow(ctx, []byte("t"), []byte("r1"), nil)
+		if err != nil || len(rows) != 1 || string(rows[0].Columns["f:a"].Value) != "v" || rows[0].Columns["f:b"].Timestamp != 7 {
+			t.Fatalf("GetRow = %v, %v", rows, err)
+		}
+	}
+	if _, err := m.GetRow(ctx, []byte("t"), []byte("x"), nil); err == nil || err.(*hbase.IOError).Message != "no row" {
+		t.Fatalf("GetRow x = %v", err)
+	}
+
+	id, err := m.ScannerOpenWithScan(ctx, []byte("t"), &hbase.TScan{StartRow: []byte("a"), StopRow: []byte("b")}, nil)
+	if err != nil || id != 3 {
+		t.Fatalf("ScannerOpenWithScan = %v, %v", id, err)
+	}
+	rows, err := m.ScannerGetList(ctx, id, 10)
+	if err != nil || len(rows) != 1 || len(rows[0].SortedColumns) != 2 || string(rows[0].SortedColumns[0].ColumnName) != "f:a" {
+		t.Fatalf("ScannerGetList = %v, %v", rows, err)
+	}
+	if err := m.ScannerClose(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if n := m.Calls("GetRow"); n != 3 {
+		t.Fatalf("Calls(GetRow) = %d", n)
+	}
+}
+
+func TestMock_Run(t *testing.T) {
+	m := hbasemock.New(t)
+	var muts []*hbase.Mutation
+	m.On("MutateRow", "t", "r", hbasemock.Any(), hbasemock.Any()).Run(func(args []interface{}) {
+		muts = args[2].([]*hbase.Mutation)
+	}).Return(nil)
+	if err := m.MutateRow(context.Background(), []byte("t"), []byte("r"), []*hbase.Mutation{{Column: []byte("f:a")}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(muts) != 1 || string(muts[0].Column) != "f:a" {
+		t.Fatalf("Run saw %v", muts)
+	}
+}
+
+func TestMock_Failures(t *testing.T) {
+	ctx := context.Background()
+	ft := &fakeT{}
+	m := hbasemock.New(ft)
+	m.On("Get", "t", "r", "f:a", nil).Return(hbasemock.Cells("v"), nil).Once()
+	m.On("IsTableEnabled", "t").Return("yes", nil)
+	m.On("DeleteTable", "t").Return(nil).Times(2)
+
+	if _, err := m.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), nil); err != nil {
+		t.Fatal(err)
+	}
+	// called once already, and an unexpected argument
+	if cells, err := m.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), nil); cells != nil || err != nil {
+		t.Fatalf("unexpected Get = %v, %v, wanted zero values", cells, err)
+	}
+	m.Get(ctx, []byte("t"), []byte("other"), []byte("f:a"), nil)
+	// result of the wrong type
+	if ok, _ := m.IsTableEnabled(ctx, []byte("t")); ok {
+		t.Fatal("wrong result type assigned")
+	}
+	m.DeleteTable(ctx, []byte("t"))
+	ft.end()
+
+	want := []string{
+		`unexpected call Get("t", "r", "f:a", map[])`,
+		`unexpected call Get("t", "other", "f:a", map[])`,
+		`result 0 of IsTableEnabled is string, not bool`,
+		`DeleteTable("t") called 1 times, expected 2`,
+	}
+	if len(ft.errors) != len(want) {
+		t.Fatalf("errors = %q", ft.errors)
+	}
+	for i, w := range want {
+		if !strings.Contains(ft.errors[i], w) {
+			t.Errorf("error %d = %q, wanted %q", i, ft.errors[i], w)
+		}
+	}
+}
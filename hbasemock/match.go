@@ -0,0 +1,113 @@
+package hbasemock
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"reflect"
+)
+
+// Matcher matches an argument of a call.
+type Matcher interface {
+	Matches(arg interface{}) bool
+	String() string
+}
+
+type matcher struct {
+	match func(interface{}) bool
+	desc  string
+}
+
+func (m matcher) Matches(arg interface{}) bool { return m.match(arg) }
+func (m matcher) String() string               { return m.desc }
+
+// Func matches the arguments for which match returns true, desc describes
+// them in failures.
+func Func(desc string, match func(arg interface{}) bool) Matcher {
+	return matcher{match, desc}
+}
+
+// Any matches any argument.
+func Any() Matcher {
+	return Func("any", func(interface{}) bool { return true })
+}
+
+// Eq matches arguments deeply equal to v. A string matches a []byte holding
+// the same bytes, nil matches nil arguments of any type, and integers match
+// integers of any type with the same value, so that On("ScannerGetList", id,
+// 10) matches an int32 count.
+func Eq(v interface{}) Matcher {
+	if v == nil {
+		return Nil()
+	}
+	if s, ok := v.(string); ok {
+		return Bytes(s)
+	}
+	if b, ok := v.([]byte); ok {
+		return Bytes(string(b))
+	}
+	return Func(format(v), func(arg interface{}) bool {
+		return reflect.DeepEqual(arg, v) || sameInt(arg, v)
+	})
+}
+
+func sameInt(a, b interface{}) bool {
+	x, ok := toInt(reflect.ValueOf(a))
+	y, ok2 := toInt(reflect.ValueOf(b))
+	return ok && ok2 && x == y
+}
+
+func toInt(v reflect.Value) (int64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	}
+	return 0, false
+}
+
+// Nil matches nil arguments, such as nil attributes or a nil *hbase.TScan.
+func Nil() Matcher {
+	return Func("nil", func(arg interface{}) bool {
+		if arg == nil {
+			return true
+		}
+		switch v := reflect.ValueOf(arg); v.Kind() {
+		case reflect.Map, reflect.Slice, reflect.Ptr, reflect.Interface, reflect.Func, reflect.Chan:
+			return v.IsNil()
+		}
+		return false
+	})
+}
+
+// Bytes matches a []byte argument holding s.
+func Bytes(s string) Matcher {
+	return Func(fmt.Sprintf("%q", s), func(arg interface{}) bool {
+		b, ok := arg.([]byte)
+		return ok && string(b) == s
+	})
+}
+
+// BytesPrefix matches a []byte argument starting with prefix.
+func BytesPrefix(prefix string) Matcher {
+	return Func(fmt.Sprintf("prefix %q", prefix), func(arg interface{}) bool {
+		b, ok := arg.([]byte)
+		return ok && bytes.HasPrefix(b, []byte(prefix))
+	})
+}
+
+// ScanRange matches a *hbase.TScan from startRow to stopRow, an empty row
+// standing for an open end.
+func ScanRange(startRow, stopRow string) Matcher {
+	return Func(fmt.Sprintf("scan [%q, %q)", startRow, stopRow), func(arg interface{}) bool {
+		s, ok := arg.(*hbase.TScan)
+		return ok && s != nil && string(s.StartRow) == startRow && string(s.StopRow) == stopRow
+	})
+}
+
+// ScanWith matches a *hbase.TScan for which match returns true.
+func ScanWith(desc string, match func(*hbase.TScan) bool) Matcher {
+	return Func(desc, func(arg interface{}) bool {
+		s, ok := arg.(*hbase.TScan)
+		return ok && s != nil && match(s)
+	})
+}
@@ -0,0 +1,301 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package hbasemock
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+)
+
+var _ hbase.Hbase = (*Mock)(nil)
+
+func (m *Mock) EnableTable(ctx context.Context, tableName []byte) (_err error) {
+	ret := m.called("EnableTable", 1, tableName)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) DisableTable(ctx context.Context, tableName []byte) (_err error) {
+	ret := m.called("DisableTable", 1, tableName)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) IsTableEnabled(ctx context.Context, tableName []byte) (_r bool, _err error) {
+	ret := m.called("IsTableEnabled", 2, tableName)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) Compact(ctx context.Context, tableNameOrRegionName []byte) (_err error) {
+	ret := m.called("Compact", 1, tableNameOrRegionName)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) MajorCompact(ctx context.Context, tableNameOrRegionName []byte) (_err error) {
+	ret := m.called("MajorCompact", 1, tableNameOrRegionName)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) GetTableNames(ctx context.Context) (_r [][]byte, _err error) {
+	ret := m.called("GetTableNames", 2)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetColumnDescriptors(ctx context.Context, tableName []byte) (_r map[string]*hbase.ColumnDescriptor, _err error) {
+	ret := m.called("GetColumnDescriptors", 2, tableName)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetTableRegions(ctx context.Context, tableName []byte) (_r []*hbase.TRegionInfo, _err error) {
+	ret := m.called("GetTableRegions", 2, tableName)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) CreateTable(ctx context.Context, tableName []byte, columnFamilies []*hbase.ColumnDescriptor) (_err error) {
+	ret := m.called("CreateTable", 1, tableName, columnFamilies)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) DeleteTable(ctx context.Context, tableName []byte) (_err error) {
+	ret := m.called("DeleteTable", 1, tableName)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) Get(ctx context.Context, tableName []byte, row []byte, column []byte, attributes map[string][]byte) (_r []*hbase.TCell, _err error) {
+	ret := m.called("Get", 2, tableName, row, column, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetVer(ctx context.Context, tableName []byte, row []byte, column []byte, numVersions int32, attributes map[string][]byte) (_r []*hbase.TCell, _err error) {
+	ret := m.called("GetVer", 2, tableName, row, column, numVersions, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetVerTs(ctx context.Context, tableName []byte, row []byte, column []byte, timestamp int64, numVersions int32, attributes map[string][]byte) (_r []*hbase.TCell, _err error) {
+	ret := m.called("GetVerTs", 2, tableName, row, column, timestamp, numVersions, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetRow(ctx context.Context, tableName []byte, row []byte, attributes map[string][]byte) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("GetRow", 2, tableName, row, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetRowWithColumns(ctx context.Context, tableName []byte, row []byte, columns [][]byte, attributes map[string][]byte) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("GetRowWithColumns", 2, tableName, row, columns, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetRowTs(ctx context.Context, tableName []byte, row []byte, timestamp int64, attributes map[string][]byte) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("GetRowTs", 2, tableName, row, timestamp, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetRowWithColumnsTs(ctx context.Context, tableName []byte, row []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("GetRowWithColumnsTs", 2, tableName, row, columns, timestamp, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetRows(ctx context.Context, tableName []byte, rows [][]byte, attributes map[string][]byte) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("GetRows", 2, tableName, rows, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetRowsWithColumns(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, attributes map[string][]byte) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("GetRowsWithColumns", 2, tableName, rows, columns, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetRowsTs(ctx context.Context, tableName []byte, rows [][]byte, timestamp int64, attributes map[string][]byte) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("GetRowsTs", 2, tableName, rows, timestamp, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) GetRowsWithColumnsTs(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("GetRowsWithColumnsTs", 2, tableName, rows, columns, timestamp, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) MutateRow(ctx context.Context, tableName []byte, row []byte, mutations []*hbase.Mutation, attributes map[string][]byte) (_err error) {
+	ret := m.called("MutateRow", 1, tableName, row, mutations, attributes)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) MutateRowTs(ctx context.Context, tableName []byte, row []byte, mutations []*hbase.Mutation, timestamp int64, attributes map[string][]byte) (_err error) {
+	ret := m.called("MutateRowTs", 1, tableName, row, mutations, timestamp, attributes)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) MutateRows(ctx context.Context, tableName []byte, rowBatches []*hbase.BatchMutation, attributes map[string][]byte) (_err error) {
+	ret := m.called("MutateRows", 1, tableName, rowBatches, attributes)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) MutateRowsTs(ctx context.Context, tableName []byte, rowBatches []*hbase.BatchMutation, timestamp int64, attributes map[string][]byte) (_err error) {
+	ret := m.called("MutateRowsTs", 1, tableName, rowBatches, timestamp, attributes)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) AtomicIncrement(ctx context.Context, tableName []byte, row []byte, column []byte, value int64) (_r int64, _err error) {
+	ret := m.called("AtomicIncrement", 2, tableName, row, column, value)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) DeleteAll(ctx context.Context, tableName []byte, row []byte, column []byte, attributes map[string][]byte) (_err error) {
+	ret := m.called("DeleteAll", 1, tableName, row, column, attributes)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) DeleteAllTs(ctx context.Context, tableName []byte, row []byte, column []byte, timestamp int64, attributes map[string][]byte) (_err error) {
+	ret := m.called("DeleteAllTs", 1, tableName, row, column, timestamp, attributes)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) DeleteAllRow(ctx context.Context, tableName []byte, row []byte, attributes map[string][]byte) (_err error) {
+	ret := m.called("DeleteAllRow", 1, tableName, row, attributes)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) Increment(ctx context.Context, increment *hbase.TIncrement) (_err error) {
+	ret := m.called("Increment", 1, increment)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) IncrementRows(ctx context.Context, increments []*hbase.TIncrement) (_err error) {
+	ret := m.called("IncrementRows", 1, increments)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) DeleteAllRowTs(ctx context.Context, tableName []byte, row []byte, timestamp int64, attributes map[string][]byte) (_err error) {
+	ret := m.called("DeleteAllRowTs", 1, tableName, row, timestamp, attributes)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) ScannerOpenWithScan(ctx context.Context, tableName []byte, scan *hbase.TScan, attributes map[string][]byte) (_r hbase.ScannerID, _err error) {
+	ret := m.called("ScannerOpenWithScan", 2, tableName, scan, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) ScannerOpen(ctx context.Context, tableName []byte, startRow []byte, columns [][]byte, attributes map[string][]byte) (_r hbase.ScannerID, _err error) {
+	ret := m.called("ScannerOpen", 2, tableName, startRow, columns, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) ScannerOpenWithStop(ctx context.Context, tableName []byte, startRow []byte, stopRow []byte, columns [][]byte, attributes map[string][]byte) (_r hbase.ScannerID, _err error) {
+	ret := m.called("ScannerOpenWithStop", 2, tableName, startRow, stopRow, columns, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) ScannerOpenWithPrefix(ctx context.Context, tableName []byte, startAndPrefix []byte, columns [][]byte, attributes map[string][]byte) (_r hbase.ScannerID, _err error) {
+	ret := m.called("ScannerOpenWithPrefix", 2, tableName, startAndPrefix, columns, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) ScannerOpenTs(ctx context.Context, tableName []byte, startRow []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (_r hbase.ScannerID, _err error) {
+	ret := m.called("ScannerOpenTs", 2, tableName, startRow, columns, timestamp, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) ScannerOpenWithStopTs(ctx context.Context, tableName []byte, startRow []byte, stopRow []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (_r hbase.ScannerID, _err error) {
+	ret := m.called("ScannerOpenWithStopTs", 2, tableName, startRow, stopRow, columns, timestamp, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) ScannerGet(ctx context.Context, id hbase.ScannerID) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("ScannerGet", 2, id)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) ScannerGetList(ctx context.Context, id hbase.ScannerID, nbRows int32) (_r []*hbase.TRowResult_, _err error) {
+	ret := m.called("ScannerGetList", 2, id, nbRows)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) ScannerClose(ctx context.Context, id hbase.ScannerID) (_err error) {
+	ret := m.called("ScannerClose", 1, id)
+	ret.assign(0, &_err)
+	return
+}
+
+func (m *Mock) GetRegionInfo(ctx context.Context, row []byte) (_r *hbase.TRegionInfo, _err error) {
+	ret := m.called("GetRegionInfo", 2, row)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) Append(ctx context.Context, append *hbase.TAppend) (_r []*hbase.TCell, _err error) {
+	ret := m.called("Append", 2, append)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
+
+func (m *Mock) CheckAndPut(ctx context.Context, tableName []byte, row []byte, column []byte, value []byte, mput *hbase.Mutation, attributes map[string][]byte) (_r bool, _err error) {
+	ret := m.called("CheckAndPut", 2, tableName, row, column, value, mput, attributes)
+	ret.assign(0, &_r)
+	ret.assign(1, &_err)
+	return
+}
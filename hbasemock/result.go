@@ -0,0 +1,73 @@
+package hbasemock
+
+import (
+	"github.com/He11oLx/hbase"
+	"sort"
+)
+
+// RowBuilder builds a canned hbase.TRowResult_.
+type RowBuilder struct {
+	r      *hbase.TRowResult_
+	sorted bool
+}
+
+// Row starts a row result for row.
+func Row(row string) *RowBuilder {
+	return &RowBuilder{r: &hbase.TRowResult_{Row: []byte(row), Columns: make(map[string]*hbase.TCell)}}
+}
+
+// Cell adds the cell of column, "family:qualifier", with value.
+func (b *RowBuilder) Cell(column, value string) *RowBuilder {
+	return b.CellTs(column, value, 0)
+}
+
+// CellTs adds the cell of column with value at ts.
+func (b *RowBuilder) CellTs(column, value string, ts int64) *RowBuilder {
+	b.r.Columns[column] = &hbase.TCell{Value: []byte(value), Timestamp: ts}
+	return b
+}
+
+// Sorted returns the cells in SortedColumns, as a gateway does for a scan
+// with SortColumns, instead of Columns.
+func (b *RowBuilder) Sorted() *RowBuilder {
+	b.sorted = true
+	return b
+}
+
+// Build returns the row result.
+func (b *RowBuilder) Build() *hbase.TRowResult_ {
+	if !b.sorted {
+		return b.r
+	}
+	r := &hbase.TRowResult_{Row: b.r.Row}
+	for c, cell := range b.r.Columns {
+		r.SortedColumns = append(r.SortedColumns, &hbase.TColumn{ColumnName: []byte(c), Cell: cell})
+	}
+	sort.Slice(r.SortedColumns, func(i, j int) bool {
+		return string(r.SortedColumns[i].ColumnName) < string(r.SortedColumns[j].ColumnName)
+	})
+	return r
+}
+
+// Rows builds the rows, the result of GetRow, ScannerGetList and the like.
+func Rows(rows ...*RowBuilder) []*hbase.TRowResult_ {
+	rs := make([]*hbase.TRowResult_, len(rows))
+	for i, b := range rows {
+		rs[i] = b.Build()
+	}
+	return rs
+}
+
+// Cells returns cells holding values, the result of Get and GetVer.
+func Cells(values ...string) []*hbase.TCell {
+	cs := make([]*hbase.TCell, len(values))
+	for i, v := range values {
+		cs[i] = &hbase.TCell{Value: []byte(v)}
+	}
+	return cs
+}
+
+// IOError returns the IOError a gateway raises with message.
+func IOError(message string) *hbase.IOError {
+	return &hbase.IOError{Message: message}
+}
@@ -0,0 +1,140 @@
+package hbase
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+	"time"
+)
+
+// Version is one version of a cell.
+type Version struct {
+	// milliseconds since the epoch, as stored by HBase
+	Timestamp int64
+	Value     []byte
+}
+
+func (v Version) Time() time.Time {
+	return time.Unix(0, v.Timestamp*int64(time.Millisecond))
+}
+
+// History is the timeline of a cell, newest version first.
+type History []Version
+
+// At returns the version that was current at t.
+func (h History) At(t time.Time) (Version, bool) {
+	ts := millis(t)
+	for _, v := range h {
+		if v.Timestamp <= ts {
+			return v, true
+		}
+	}
+	return Version{}, false
+}
+
+// HistoryOptions limits GetHistory. Zero values mean no limit.
+type HistoryOptions struct {
+	MaxVersions int32
+	// both inclusive
+	Since, Until time.Time
+}
+
+func millis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// GetHistory returns the versions of a cell the table still retains (see the
+// family's MaxVersions), newest first.
+func (p *Client) GetHistory(ctx context.Context, tableName []byte, row []byte, column []byte, opts *HistoryOptions) (History, error) {
+	if opts == nil {
+		opts = &HistoryOptions{}
+	}
+	n := opts.MaxVersions
+	if n <= 0 {
+		n = math.MaxInt32
+	}
+	var (
+		cells []*TCell
+		err   error
+	)
+	if opts.Until.IsZero() {
+		cells, err = p.GetVer(ctx, tableName, row, column, n, nil)
+	} else {
+		// the server's upper bound is exclusive
+		cells, err = p.GetVerTs(ctx, tableName, row, column, millis(opts.Until)+1, n, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	since := int64(math.MinInt64)
+	if !opts.Since.IsZero() {
+		since = millis(opts.Since)
+	}
+	h := make(History, 0, len(cells))
+	for _, c := range cells {
+		if c.Timestamp >= since {
+			h = append(h, Version{Timestamp: c.Timestamp, Value: c.Value})
+		}
+	}
+	return h, nil
+}
+
+// GetRowAsOf returns the row as it was at t, restricted to columns if any are
+// given. It returns nil if the row had no cells at t.
+func (p *Client) GetRowAsOf(ctx context.Context, tableName []byte, row []byte, columns [][]byte, t time.Time) (*TRowResult_, error) {
+	rows, err := p.GetRowWithColumnsTs(ctx, tableName, row, columns, millis(t)+1, nil)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+// CellChange describes how a column differs between two points in time.
+// Before is nil for added columns and After is nil for removed ones.
+type CellChange struct {
+	Column string
+	Before *TCell
+	After  *TCell
+}
+
+// DiffRow compares the row at from with the row at to and returns the changed
+// columns sorted by name. Deletes are only visible while the deleted versions
+// are still retained by the server.
+func (p *Client) DiffRow(ctx context.Context, tableName []byte, row []byte, columns [][]byte, from, to time.Time) ([]CellChange, error) {
+	before, err := p.GetRowAsOf(ctx, tableName, row, columns, from)
+	if err != nil {
+		return nil, err
+	}
+	after, err := p.GetRowAsOf(ctx, tableName, row, columns, to)
+	if err != nil {
+		return nil, err
+	}
+	return diffRows(before, after), nil
+}
+
+func diffRows(before, after *TRowResult_) []CellChange {
+	var b, a map[string]*TCell
+	if before != nil {
+		b = before.Columns
+	}
+	if after != nil {
+		a = after.Columns
+	}
+	var r []CellChange
+	for col, cb := range b {
+		ca, ok := a[col]
+		if !ok {
+			r = append(r, CellChange{Column: col, Before: cb})
+		} else if ca.Timestamp != cb.Timestamp || !bytes.Equal(ca.Value, cb.Value) {
+			r = append(r, CellChange{Column: col, Before: cb, After: ca})
+		}
+	}
+	for col, ca := range a {
+		if _, ok := b[col]; !ok {
+			r = append(r, CellChange{Column: col, After: ca})
+		}
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].Column < r[j].Column })
+	return r
+}
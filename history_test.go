@@ -0,0 +1,67 @@
+package hbase_test
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"testing"
+	"time"
+)
+
+func TestClient_GetHistory(t *testing.T) {
+	ctx := context.Background()
+	c := memhbase.NewTable(t, "audit", "f").Client()
+	table, row := []byte("audit"), []byte("r1")
+	put := func(ts int64, column, value string) {
+		m := hbase.NewMutation()
+		m.Column, m.Value = []byte(column), []byte(value)
+		if err := c.MutateRowTs(ctx, table, row, []*hbase.Mutation{m}, ts, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	at := func(ts int64) time.Time { return time.Unix(0, ts*int64(time.Millisecond)) }
+	put(1000, "f:a", "a1")
+	put(2000, "f:a", "a2")
+	put(2000, "f:b", "b2")
+	put(3000, "f:a", "a3")
+
+	h, err := c.GetHistory(ctx, table, row, []byte("f:a"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 3 || string(h[0].Value) != "a3" || h[2].Timestamp != 1000 {
+		t.Fatalf("history %v", h)
+	}
+	if v, ok := h.At(at(2500)); !ok || string(v.Value) != "a2" {
+		t.Fatalf("At(2500) = %s, %v", v.Value, ok)
+	}
+	h, err = c.GetHistory(ctx, table, row, []byte("f:a"), &hbase.HistoryOptions{Since: at(2000), Until: at(2000)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 1 || string(h[0].Value) != "a2" {
+		t.Fatalf("bounded history %v", h)
+	}
+
+	r, err := c.GetRowAsOf(ctx, table, row, nil, at(1500))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Columns) != 1 || string(r.Columns["f:a"].Value) != "a1" {
+		t.Fatalf("row as of 1500: %v", r)
+	}
+
+	changes, err := c.DiffRow(ctx, table, row, nil, at(1500), at(3000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("changes %v", changes)
+	}
+	if changes[0].Column != "f:a" || string(changes[0].Before.Value) != "a1" || string(changes[0].After.Value) != "a3" {
+		t.Errorf("f:a change %+v", changes[0])
+	}
+	if changes[1].Column != "f:b" || changes[1].Before != nil || string(changes[1].After.Value) != "b2" {
+		t.Errorf("f:b change %+v", changes[1])
+	}
+}
@@ -0,0 +1,96 @@
+package hbase
+
+import (
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"net/http"
+	"time"
+)
+
+var (
+	DefaultHTTPTimeout      = 30 * time.Second
+	DefaultHTTPIdleTimeout  = 90 * time.Second
+	DefaultHTTPMaxIdleConns = 10
+)
+
+// HTTPOptions configures the transport to a Thrift gateway running in HTTP
+// mode (hbase.regionserver.thrift.http), e.g. behind an HTTP ingress.
+type HTTPOptions struct {
+	// Header is sent with every request, e.g. credentials for the ingress.
+	Header http.Header
+	// Timeout bounds every call, DefaultHTTPTimeout if 0.
+	Timeout time.Duration
+	// MaxIdleConns is the number of keep-alive connections kept open to the
+	// gateway, DefaultHTTPMaxIdleConns if 0.
+	MaxIdleConns int
+	// IdleTimeout closes keep-alive connections idle for longer,
+	// DefaultHTTPIdleTimeout if 0.
+	IdleTimeout time.Duration
+	// Client replaces the http.Client built from the options above.
+	Client *http.Client
+}
+
+// HTTPClient returns o.Client, or a new http.Client configured by o. Sharing
+// it between transports shares its keep-alive connections.
+func (o *HTTPOptions) HTTPClient() *http.Client {
+	if o == nil {
+		o = &HTTPOptions{}
+	}
+	if o.Client != nil {
+		return o.Client
+	}
+	timeout, idleTimeout, maxIdleConns := o.Timeout, o.IdleTimeout, o.MaxIdleConns
+	if timeout <= 0 {
+		timeout = DefaultHTTPTimeout
+	}
+	if idleTimeout <= 0 {
+		idleTimeout = DefaultHTTPIdleTimeout
+	}
+	if maxIdleConns <= 0 {
+		maxIdleConns = DefaultHTTPMaxIdleConns
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			DialContext:         (&net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}).DialContext,
+			MaxIdleConns:        maxIdleConns,
+			MaxIdleConnsPerHost: maxIdleConns,
+			IdleConnTimeout:     idleTimeout,
+		},
+	}
+}
+
+// NewHTTPTransport returns a transport posting calls to url with the header
+// of o, and the doAs parameter of a context from WithDoAs. A transport serves
+// one call at a time.
+func NewHTTPTransport(url string, o *HTTPOptions) (*thrift.THttpClient, error) {
+	client := *o.HTTPClient()
+	if _, ok := client.Transport.(doAsTransport); !ok {
+		client.Transport = doAsTransport{base: client.Transport}
+	}
+	trans, err := thrift.NewTHttpClientWithOptions(url, thrift.THttpClientOptions{Client: &client})
+	if err != nil {
+		return nil, err
+	}
+	t := trans.(*thrift.THttpClient)
+	if o != nil {
+		for key, values := range o.Header {
+			for _, value := range values {
+				t.SetHeader(key, value)
+			}
+		}
+	}
+	return t, nil
+}
+
+// NewHTTPClient returns a Client calling the gateway at url, e.g.
+// "http://localhost:9090/". Like every Client over a single transport, it is
+// not safe for concurrent use, see pool.NewTHttpPoolClient.
+func NewHTTPClient(url string, protocolFactory thrift.TProtocolFactory, o *HTTPOptions) (*Client, error) {
+	trans, err := NewHTTPTransport(url, o)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientFactory(trans, protocolFactory), nil
+}
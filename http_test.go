@@ -0,0 +1,81 @@
+package hbase_test
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newHTTPServer(t *testing.T, handler hbase.Hbase, wrap func(http.Handler) http.Handler) *httptest.Server {
+	f := thrift.NewTBinaryProtocolFactoryConf(nil)
+	var h http.Handler = http.HandlerFunc(thrift.NewThriftHandlerFunc(hbase.NewProcessor(handler), f, f))
+	if wrap != nil {
+		h = wrap(h)
+	}
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	ctx := context.Background()
+	var auth []string
+	s := newHTTPServer(t, memhbase.New(), func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = append(auth, r.Header.Get("Authorization"))
+			h.ServeHTTP(w, r)
+		})
+	})
+
+	c, err := hbase.NewHTTPClient(s.URL, thrift.NewTBinaryProtocolFactoryConf(nil), &hbase.HTTPOptions{
+		Header: http.Header{"Authorization": {"Bearer token"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = []byte("f:")
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	m := hbase.NewMutation()
+	m.Column, m.Value = []byte("f:a"), []byte("v")
+	if err := c.MutateRow(ctx, []byte("t"), []byte("r"), []*hbase.Mutation{m}, nil); err != nil {
+		t.Fatal(err)
+	}
+	cells, err := c.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), nil)
+	if err != nil || len(cells) != 1 || string(cells[0].Value) != "v" {
+		t.Fatalf("Get = %v, %v", cells, err)
+	}
+	if _, err := c.Get(ctx, []byte("missing"), []byte("r"), []byte("f:a"), nil); err == nil {
+		t.Fatal("wanted IOError for a missing table")
+	} else if _, ok := err.(*hbase.IOError); !ok {
+		t.Fatalf("wanted IOError, got %T %v", err, err)
+	}
+	for i, a := range auth {
+		if a != "Bearer token" {
+			t.Fatalf("request %d Authorization = %q", i, a)
+		}
+	}
+}
+
+func TestNewHTTPClient_Timeout(t *testing.T) {
+	s := newHTTPServer(t, memhbase.New(), func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+			h.ServeHTTP(w, r)
+		})
+	})
+	c, err := hbase.NewHTTPClient(s.URL, thrift.NewTBinaryProtocolFactoryConf(nil), &hbase.HTTPOptions{Timeout: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTableNames(context.Background()); err == nil {
+		t.Fatal("wanted a timeout")
+	}
+}
@@ -0,0 +1,78 @@
+package memhbase
+
+import (
+	"bytes"
+	"github.com/He11oLx/hbase"
+	"regexp"
+	"strings"
+)
+
+// filter supports the subset of the HBase filter language used by this
+// repository: filters joined with AND.
+type filter []func(*hbase.TRowResult_) *hbase.TRowResult_
+
+var filterRe = regexp.MustCompile(`^\s*(\w+)\s*\(\s*(?:'((?:[^']|'')*)')?\s*\)\s*$`)
+
+func parseFilter(s string) (filter, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var f filter
+	for _, part := range strings.Split(s, " AND ") {
+		m := filterRe.FindStringSubmatch(part)
+		if m == nil {
+			return nil, &hbase.IOError{Message: "Incorrect filter string " + s}
+		}
+		arg := []byte(strings.Replace(m[2], "''", "'", -1))
+		switch m[1] {
+		case "PrefixFilter":
+			f = append(f, func(r *hbase.TRowResult_) *hbase.TRowResult_ {
+				if !bytes.HasPrefix(r.Row, arg) {
+					return nil
+				}
+				return r
+			})
+		case "FirstKeyOnlyFilter":
+			f = append(f, firstKeyOnly)
+		case "KeyOnlyFilter":
+			f = append(f, keyOnly)
+		default:
+			return nil, &hbase.IOError{Message: "Filter Name " + m[1] + " not supported"}
+		}
+	}
+	return f, nil
+}
+
+func (f filter) apply(r *hbase.TRowResult_) *hbase.TRowResult_ {
+	for _, fn := range f {
+		if r = fn(r); r == nil {
+			return nil
+		}
+	}
+	return r
+}
+
+func firstKeyOnly(r *hbase.TRowResult_) *hbase.TRowResult_ {
+	if len(r.SortedColumns) > 0 {
+		r.SortedColumns = r.SortedColumns[:1]
+		return r
+	}
+	first := ""
+	for col := range r.Columns {
+		if first == "" || col < first {
+			first = col
+		}
+	}
+	r.Columns = map[string]*hbase.TCell{first: r.Columns[first]}
+	return r
+}
+
+func keyOnly(r *hbase.TRowResult_) *hbase.TRowResult_ {
+	for _, c := range r.SortedColumns {
+		c.Cell = &hbase.TCell{Value: []byte{}, Timestamp: c.Cell.Timestamp}
+	}
+	for col, c := range r.Columns {
+		r.Columns[col] = &hbase.TCell{Value: []byte{}, Timestamp: c.Timestamp}
+	}
+	return r
+}
@@ -0,0 +1,38 @@
+package memhbase
+
+import (
+	"context"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"testing"
+)
+
+// NewTable returns a Server with an empty table of the given families, e.g.
+// "f", failing t if it cannot be created.
+func NewTable(t testing.TB, table string, families ...string) *Server {
+	t.Helper()
+	s := New()
+	var cds []*hbase.ColumnDescriptor
+	for _, f := range families {
+		cd := hbase.NewColumnDescriptor()
+		cd.Name = []byte(f + ":")
+		cds = append(cds, cd)
+	}
+	if err := s.CreateTable(context.Background(), []byte(table), cds); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// PutRows puts n rows "r00", "r01"... in table, with the value "v" in
+// column, failing t on error.
+func (s *Server) PutRows(t testing.TB, table, column string, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		m := hbase.NewMutation()
+		m.Column, m.Value = []byte(column), []byte("v")
+		if err := s.MutateRow(context.Background(), []byte(table), []byte(fmt.Sprintf("r%02d", i)), []*hbase.Mutation{m}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package memhbase
+
+import (
+	"context"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"sync"
+)
+
+// Listener serves a processor with the binary protocol on every connection
+// accepted, standing in for a Thrift gateway in socket mode.
+type Listener struct {
+	Addr string
+
+	ln    net.Listener
+	wrap  func(net.Conn) (thrift.TTransport, error)
+	p     thrift.TProcessor
+	wg    sync.WaitGroup
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+// Listen starts serving p on ln, e.g. a tls.NewListener. wrap, if not nil,
+// replaces the plain socket transport of a connection, e.g. to negotiate a
+// security layer first.
+func Listen(p thrift.TProcessor, ln net.Listener, wrap func(net.Conn) (thrift.TTransport, error)) *Listener {
+	if wrap == nil {
+		wrap = func(conn net.Conn) (thrift.TTransport, error) {
+			return thrift.NewTSocketFromConnConf(conn, nil), nil
+		}
+	}
+	l := &Listener{
+		Addr:  ln.Addr().String(),
+		ln:    ln,
+		wrap:  wrap,
+		p:     p,
+		conns: make(map[net.Conn]struct{}),
+	}
+	l.wg.Add(1)
+	go l.accept()
+	return l
+}
+
+func (l *Listener) accept() {
+	defer l.wg.Done()
+	for {
+		conn, err := l.ln.Accept()
+		if err != nil {
+			return
+		}
+		l.mu.Lock()
+		l.conns[conn] = struct{}{}
+		l.mu.Unlock()
+		l.wg.Add(1)
+		go l.serve(conn)
+	}
+}
+
+func (l *Listener) serve(conn net.Conn) {
+	defer l.wg.Done()
+	defer func() {
+		conn.Close()
+		l.mu.Lock()
+		delete(l.conns, conn)
+		l.mu.Unlock()
+	}()
+	trans, err := l.wrap(conn)
+	if err != nil {
+		return
+	}
+	prot := thrift.NewTBinaryProtocolConf(trans, nil)
+	for {
+		ok, err := l.p.Process(context.Background(), prot, prot)
+		if _, isTransport := err.(thrift.TTransportException); !ok || isTransport {
+			return
+		}
+	}
+}
+
+// Close stops accepting, closes every connection and waits for them.
+func (l *Listener) Close() error {
+	err := l.ln.Close()
+	l.mu.Lock()
+	for conn := range l.conns {
+		conn.Close()
+	}
+	l.mu.Unlock()
+	l.wg.Wait()
+	return err
+}
@@ -0,0 +1,62 @@
+package memhbase
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"sync/atomic"
+)
+
+// Loopback is a thrift.TClient that serializes every call and serves it with
+// a Processor in process, so hbase.Client can be tested against a Server
+// through the generated bindings.
+type Loopback struct {
+	p     thrift.TProcessor
+	f     thrift.TProtocolFactory
+	seqId int32
+}
+
+func NewLoopback(handler hbase.Hbase) *Loopback {
+	return NewProcessorLoopback(hbase.NewProcessor(handler))
+}
+
+// NewProcessorLoopback serves calls with any processor, e.g. the one of the
+// thrift2 bindings.
+func NewProcessorLoopback(p thrift.TProcessor) *Loopback {
+	return &Loopback{
+		p: p,
+		f: thrift.NewTBinaryProtocolFactoryConf(nil),
+	}
+}
+
+// Client returns a hbase.Client backed by s.
+func (s *Server) Client() *hbase.Client {
+	return hbase.NewClient(NewLoopback(s))
+}
+
+func (l *Loopback) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	seqId := atomic.AddInt32(&l.seqId, 1)
+	req, resp := thrift.NewTMemoryBuffer(), thrift.NewTMemoryBuffer()
+
+	oprot := l.f.GetProtocol(req)
+	if err := oprot.WriteMessageBegin(ctx, method, thrift.CALL, seqId); err != nil {
+		return thrift.ResponseMeta{}, err
+	}
+	if err := args.Write(ctx, oprot); err != nil {
+		return thrift.ResponseMeta{}, err
+	}
+	if err := oprot.WriteMessageEnd(ctx); err != nil {
+		return thrift.ResponseMeta{}, err
+	}
+	if err := oprot.Flush(ctx); err != nil {
+		return thrift.ResponseMeta{}, err
+	}
+
+	// handler errors reach the client as exceptions written to resp
+	l.p.Process(ctx, l.f.GetProtocol(req), l.f.GetProtocol(resp))
+	if result == nil {
+		return thrift.ResponseMeta{}, nil
+	}
+	iprot := l.f.GetProtocol(resp)
+	return thrift.ResponseMeta{}, thrift.NewTStandardClient(iprot, oprot).Recv(ctx, iprot, seqId, method, result)
+}
@@ -0,0 +1,811 @@
+// Package memhbase is an in-memory implementation of the hbase.Hbase
+// interface. It follows the semantics of the HBase Thrift1 gateway closely
+// enough for unit tests of the helpers in this repository.
+package memhbase
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ hbase.Hbase = (*Server)(nil)
+
+type Server struct {
+	mu       sync.Mutex
+	tables   map[string]*table
+	scanners map[hbase.ScannerID]*scanner
+	nextID   hbase.ScannerID
+	lastTs   int64
+	// namespace -> configuration, only checked by the Thrift2 view
+	namespaces map[string]map[string]string
+}
+
+type table struct {
+	name     string
+	enabled  bool
+	families map[string]*hbase.ColumnDescriptor
+	// row -> column -> versions, newest first
+	rows   map[string]map[string][]*hbase.TCell
+	splits [][]byte
+}
+
+type scanner struct {
+	rows []*hbase.TRowResult_
+	pos  int
+}
+
+func New() *Server {
+	return &Server{
+		tables:     make(map[string]*table),
+		scanners:   make(map[hbase.ScannerID]*scanner),
+		namespaces: map[string]map[string]string{"default": {}, "hbase": {}},
+	}
+}
+
+// Split sets the region boundaries reported by GetTableRegions.
+func (s *Server) Split(tableName []byte, keys ...[]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(tableName)
+	if err != nil {
+		return err
+	}
+	splits := make([][]byte, len(keys))
+	copy(splits, keys)
+	sort.Slice(splits, func(i, j int) bool { return bytes.Compare(splits[i], splits[j]) < 0 })
+	t.splits = splits
+	return nil
+}
+
+// ExpireScanners drops every open scanner, as a gateway restart would.
+func (s *Server) ExpireScanners() {
+	s.mu.Lock()
+	s.scanners = make(map[hbase.ScannerID]*scanner)
+	s.mu.Unlock()
+}
+
+func (s *Server) now() int64 {
+	ts := time.Now().UnixNano() / int64(time.Millisecond)
+	if ts <= s.lastTs {
+		ts = s.lastTs + 1
+	}
+	s.lastTs = ts
+	return ts
+}
+
+func (s *Server) table(name []byte) (*table, error) {
+	t, ok := s.tables[string(name)]
+	if !ok {
+		return nil, &hbase.IOError{Message: "org.apache.hadoop.hbase.TableNotFoundException: " + string(name)}
+	}
+	return t, nil
+}
+
+func (s *Server) enabledTable(name []byte) (*table, error) {
+	t, err := s.table(name)
+	if err != nil {
+		return nil, err
+	}
+	if !t.enabled {
+		return nil, &hbase.IOError{Message: "org.apache.hadoop.hbase.TableNotEnabledException: " + string(name)}
+	}
+	return t, nil
+}
+
+// sortColumns sorts "family:qualifier" columns by family, then qualifier, as
+// HBase orders cells, which puts "f:a" before "f1:a".
+func sortColumns(columns []string) {
+	sort.Slice(columns, func(i, j int) bool {
+		fi, qi, _ := splitColumn([]byte(columns[i]))
+		fj, qj, _ := splitColumn([]byte(columns[j]))
+		if fi != fj {
+			return fi < fj
+		}
+		return qi < qj
+	})
+}
+
+func splitColumn(column []byte) (family, qualifier string, hasQualifier bool) {
+	c := string(column)
+	i := strings.IndexByte(c, ':')
+	if i < 0 {
+		return c, "", false
+	}
+	return c[:i], c[i+1:], i+1 < len(c)
+}
+
+func (t *table) checkFamily(column []byte) error {
+	family, _, _ := splitColumn(column)
+	if _, ok := t.families[family]; !ok {
+		return &hbase.IOError{Message: fmt.Sprintf("org.apache.hadoop.hbase.regionserver.NoSuchColumnFamilyException: Column family %s does not exist in region %s", family, t.name)}
+	}
+	return nil
+}
+
+func matchColumn(column string, columns [][]byte) bool {
+	if len(columns) == 0 {
+		return true
+	}
+	for _, c := range columns {
+		family, _, hasQualifier := splitColumn(c)
+		if hasQualifier {
+			if column == string(c) {
+				return true
+			}
+		} else if strings.HasPrefix(column, family+":") {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *table) put(row []byte, column []byte, value []byte, ts int64) {
+	cols, ok := t.rows[string(row)]
+	if !ok {
+		cols = make(map[string][]*hbase.TCell)
+		t.rows[string(row)] = cols
+	}
+	col := string(column)
+	if !strings.Contains(col, ":") {
+		col += ":"
+	}
+	v := make([]byte, len(value))
+	copy(v, value)
+	versions := cols[col]
+	i := sort.Search(len(versions), func(i int) bool { return versions[i].Timestamp <= ts })
+	if i < len(versions) && versions[i].Timestamp == ts {
+		versions[i] = &hbase.TCell{Value: v, Timestamp: ts}
+	} else {
+		versions = append(versions, nil)
+		copy(versions[i+1:], versions[i:])
+		versions[i] = &hbase.TCell{Value: v, Timestamp: ts}
+	}
+	family, _, _ := splitColumn(column)
+	if cd := t.families[family]; cd != nil && cd.MaxVersions > 0 && len(versions) > int(cd.MaxVersions) {
+		versions = versions[:cd.MaxVersions]
+	}
+	cols[col] = versions
+}
+
+// delete removes versions of the matching columns with a timestamp <= ts.
+func (t *table) delete(row []byte, column []byte, ts int64) {
+	cols, ok := t.rows[string(row)]
+	if !ok {
+		return
+	}
+	var match [][]byte
+	if column != nil {
+		match = [][]byte{column}
+	}
+	for col, versions := range cols {
+		if !matchColumn(col, match) {
+			continue
+		}
+		kept := versions[:0]
+		for _, c := range versions {
+			if c.Timestamp > ts {
+				kept = append(kept, c)
+			}
+		}
+		if len(kept) == 0 {
+			delete(cols, col)
+		} else {
+			cols[col] = kept
+		}
+	}
+	if len(cols) == 0 {
+		delete(t.rows, string(row))
+	}
+}
+
+func (t *table) latest(row []byte, column []byte) *hbase.TCell {
+	col := string(column)
+	if !strings.Contains(col, ":") {
+		col += ":"
+	}
+	if versions := t.rows[string(row)][col]; len(versions) > 0 {
+		return versions[0]
+	}
+	return nil
+}
+
+// versions returns up to n versions with a timestamp < before, newest first.
+func (t *table) versions(row []byte, column []byte, before int64, n int32) []*hbase.TCell {
+	col := string(column)
+	if !strings.Contains(col, ":") {
+		col += ":"
+	}
+	r := make([]*hbase.TCell, 0)
+	for _, c := range t.rows[string(row)][col] {
+		if n > 0 && int32(len(r)) >= n {
+			break
+		}
+		if c.Timestamp < before {
+			r = append(r, &hbase.TCell{Value: c.Value, Timestamp: c.Timestamp})
+		}
+	}
+	return r
+}
+
+func (t *table) rowResult(row string, columns [][]byte, before int64, sorted bool) *hbase.TRowResult_ {
+	cols, ok := t.rows[row]
+	if !ok {
+		return nil
+	}
+	r := &hbase.TRowResult_{Row: []byte(row)}
+	names := make([]string, 0, len(cols))
+	for col := range cols {
+		if matchColumn(col, columns) {
+			names = append(names, col)
+		}
+	}
+	sortColumns(names)
+	for _, col := range names {
+		for _, c := range cols[col] {
+			if c.Timestamp < before {
+				cell := &hbase.TCell{Value: c.Value, Timestamp: c.Timestamp}
+				if sorted {
+					r.SortedColumns = append(r.SortedColumns, &hbase.TColumn{ColumnName: []byte(col), Cell: cell})
+				} else {
+					if r.Columns == nil {
+						r.Columns = make(map[string]*hbase.TCell)
+					}
+					r.Columns[col] = cell
+				}
+				break
+			}
+		}
+	}
+	if r.Columns == nil && r.SortedColumns == nil {
+		return nil
+	}
+	return r
+}
+
+func (t *table) getRows(rows [][]byte, columns [][]byte, before int64) []*hbase.TRowResult_ {
+	r := make([]*hbase.TRowResult_, 0, len(rows))
+	for _, row := range rows {
+		if res := t.rowResult(string(row), columns, before, false); res != nil {
+			r = append(r, res)
+		}
+	}
+	return r
+}
+
+func (s *Server) EnableTable(ctx context.Context, tableName []byte) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(tableName)
+	if err != nil {
+		return err
+	}
+	t.enabled = true
+	return nil
+}
+
+func (s *Server) DisableTable(ctx context.Context, tableName []byte) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(tableName)
+	if err != nil {
+		return err
+	}
+	t.enabled = false
+	return nil
+}
+
+func (s *Server) IsTableEnabled(ctx context.Context, tableName []byte) (r bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(tableName)
+	if err != nil {
+		return false, err
+	}
+	return t.enabled, nil
+}
+
+func (s *Server) Compact(ctx context.Context, tableNameOrRegionName []byte) (err error) {
+	return nil
+}
+
+func (s *Server) MajorCompact(ctx context.Context, tableNameOrRegionName []byte) (err error) {
+	return nil
+}
+
+func (s *Server) GetTableNames(ctx context.Context) (r [][]byte, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r = make([][]byte, 0, len(s.tables))
+	for name := range s.tables {
+		r = append(r, []byte(name))
+	}
+	sort.Slice(r, func(i, j int) bool { return bytes.Compare(r[i], r[j]) < 0 })
+	return r, nil
+}
+
+func (s *Server) GetColumnDescriptors(ctx context.Context, tableName []byte) (r map[string]*hbase.ColumnDescriptor, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(tableName)
+	if err != nil {
+		return nil, err
+	}
+	r = make(map[string]*hbase.ColumnDescriptor, len(t.families))
+	for family, cd := range t.families {
+		c := *cd
+		r[family+":"] = &c
+	}
+	return r, nil
+}
+
+func (s *Server) GetTableRegions(ctx context.Context, tableName []byte) (r []*hbase.TRegionInfo, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(tableName)
+	if err != nil {
+		return nil, err
+	}
+	start := []byte{}
+	for i := 0; i <= len(t.splits); i++ {
+		end := []byte{}
+		if i < len(t.splits) {
+			end = t.splits[i]
+		}
+		r = append(r, &hbase.TRegionInfo{
+			StartKey:   start,
+			EndKey:     end,
+			ID:         int64(i + 1),
+			Name:       []byte(fmt.Sprintf("%s,%s,%d", t.name, start, i+1)),
+			ServerName: []byte("localhost"),
+			Port:       16020,
+		})
+		start = end
+	}
+	return r, nil
+}
+
+func (s *Server) CreateTable(ctx context.Context, tableName []byte, columnFamilies []*hbase.ColumnDescriptor) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tables[string(tableName)]; ok {
+		return &hbase.AlreadyExists{Message: "table name already in use"}
+	}
+	if len(columnFamilies) == 0 {
+		return &hbase.IllegalArgument{Message: "table must have at least one column family"}
+	}
+	t := &table{
+		name:     string(tableName),
+		enabled:  true,
+		families: make(map[string]*hbase.ColumnDescriptor),
+		rows:     make(map[string]map[string][]*hbase.TCell),
+	}
+	for _, cd := range columnFamilies {
+		c := *cd
+		t.families[strings.TrimSuffix(string(cd.Name), ":")] = &c
+	}
+	s.tables[string(tableName)] = t
+	return nil
+}
+
+func (s *Server) DeleteTable(ctx context.Context, tableName []byte) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(tableName)
+	if err != nil {
+		return err
+	}
+	if t.enabled {
+		return &hbase.IOError{Message: "org.apache.hadoop.hbase.TableNotDisabledException: " + t.name}
+	}
+	delete(s.tables, t.name)
+	return nil
+}
+
+func (s *Server) Get(ctx context.Context, tableName []byte, row []byte, column []byte, attributes map[string][]byte) (r []*hbase.TCell, err error) {
+	return s.GetVerTs(ctx, tableName, row, column, math.MaxInt64, 1, attributes)
+}
+
+func (s *Server) GetVer(ctx context.Context, tableName []byte, row []byte, column []byte, numVersions int32, attributes map[string][]byte) (r []*hbase.TCell, err error) {
+	return s.GetVerTs(ctx, tableName, row, column, math.MaxInt64, numVersions, attributes)
+}
+
+func (s *Server) GetVerTs(ctx context.Context, tableName []byte, row []byte, column []byte, timestamp int64, numVersions int32, attributes map[string][]byte) (r []*hbase.TCell, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.checkFamily(column); err != nil {
+		return nil, err
+	}
+	return t.versions(row, column, timestamp, numVersions), nil
+}
+
+func (s *Server) GetRow(ctx context.Context, tableName []byte, row []byte, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	return s.GetRowsWithColumnsTs(ctx, tableName, [][]byte{row}, nil, math.MaxInt64, attributes)
+}
+
+func (s *Server) GetRowWithColumns(ctx context.Context, tableName []byte, row []byte, columns [][]byte, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	return s.GetRowsWithColumnsTs(ctx, tableName, [][]byte{row}, columns, math.MaxInt64, attributes)
+}
+
+func (s *Server) GetRowTs(ctx context.Context, tableName []byte, row []byte, timestamp int64, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	return s.GetRowsWithColumnsTs(ctx, tableName, [][]byte{row}, nil, timestamp, attributes)
+}
+
+func (s *Server) GetRowWithColumnsTs(ctx context.Context, tableName []byte, row []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	return s.GetRowsWithColumnsTs(ctx, tableName, [][]byte{row}, columns, timestamp, attributes)
+}
+
+func (s *Server) GetRows(ctx context.Context, tableName []byte, rows [][]byte, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	return s.GetRowsWithColumnsTs(ctx, tableName, rows, nil, math.MaxInt64, attributes)
+}
+
+func (s *Server) GetRowsWithColumns(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	return s.GetRowsWithColumnsTs(ctx, tableName, rows, columns, math.MaxInt64, attributes)
+}
+
+func (s *Server) GetRowsTs(ctx context.Context, tableName []byte, rows [][]byte, timestamp int64, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	return s.GetRowsWithColumnsTs(ctx, tableName, rows, nil, timestamp, attributes)
+}
+
+func (s *Server) GetRowsWithColumnsTs(ctx context.Context, tableName []byte, rows [][]byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (r []*hbase.TRowResult_, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range columns {
+		if err = t.checkFamily(c); err != nil {
+			return nil, err
+		}
+	}
+	return t.getRows(rows, columns, timestamp), nil
+}
+
+func (t *table) mutate(row []byte, mutations []*hbase.Mutation, ts int64, explicit bool) error {
+	for _, m := range mutations {
+		if err := t.checkFamily(m.Column); err != nil {
+			return err
+		}
+	}
+	for _, m := range mutations {
+		if m.IsDelete {
+			deleteTs := int64(math.MaxInt64)
+			if explicit {
+				deleteTs = ts
+			}
+			t.delete(row, m.Column, deleteTs)
+		} else {
+			t.put(row, m.Column, m.Value, ts)
+		}
+	}
+	return nil
+}
+
+func (s *Server) MutateRow(ctx context.Context, tableName []byte, row []byte, mutations []*hbase.Mutation, attributes map[string][]byte) (err error) {
+	return s.mutateRows(tableName, []*hbase.BatchMutation{{Row: row, Mutations: mutations}}, 0, false)
+}
+
+func (s *Server) MutateRowTs(ctx context.Context, tableName []byte, row []byte, mutations []*hbase.Mutation, timestamp int64, attributes map[string][]byte) (err error) {
+	return s.mutateRows(tableName, []*hbase.BatchMutation{{Row: row, Mutations: mutations}}, timestamp, true)
+}
+
+func (s *Server) MutateRows(ctx context.Context, tableName []byte, rowBatches []*hbase.BatchMutation, attributes map[string][]byte) (err error) {
+	return s.mutateRows(tableName, rowBatches, 0, false)
+}
+
+func (s *Server) MutateRowsTs(ctx context.Context, tableName []byte, rowBatches []*hbase.BatchMutation, timestamp int64, attributes map[string][]byte) (err error) {
+	return s.mutateRows(tableName, rowBatches, timestamp, true)
+}
+
+func (s *Server) mutateRows(tableName []byte, rowBatches []*hbase.BatchMutation, ts int64, explicit bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(tableName)
+	if err != nil {
+		return err
+	}
+	if !explicit {
+		ts = s.now()
+	}
+	for _, b := range rowBatches {
+		if err = t.mutate(b.Row, b.Mutations, ts, explicit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *table) increment(row []byte, column []byte, amount int64, ts int64) (int64, error) {
+	if err := t.checkFamily(column); err != nil {
+		return 0, err
+	}
+	var v int64
+	if c := t.latest(row, column); c != nil {
+		if len(c.Value) != 8 {
+			return 0, &hbase.IOError{Message: "org.apache.hadoop.hbase.DoNotRetryIOException: Field is not a long, it's " + fmt.Sprint(len(c.Value)) + " bytes wide"}
+		}
+		v = int64(binary.BigEndian.Uint64(c.Value))
+	}
+	v += amount
+	if amount != 0 {
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, uint64(v))
+		t.put(row, column, buf, ts)
+	}
+	return v, nil
+}
+
+func (s *Server) AtomicIncrement(ctx context.Context, tableName []byte, row []byte, column []byte, value int64) (r int64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(tableName)
+	if err != nil {
+		return 0, err
+	}
+	return t.increment(row, column, value, s.now())
+}
+
+func (s *Server) DeleteAll(ctx context.Context, tableName []byte, row []byte, column []byte, attributes map[string][]byte) (err error) {
+	return s.DeleteAllTs(ctx, tableName, row, column, math.MaxInt64, attributes)
+}
+
+func (s *Server) DeleteAllTs(ctx context.Context, tableName []byte, row []byte, column []byte, timestamp int64, attributes map[string][]byte) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(tableName)
+	if err != nil {
+		return err
+	}
+	if err = t.checkFamily(column); err != nil {
+		return err
+	}
+	t.delete(row, column, timestamp)
+	return nil
+}
+
+func (s *Server) DeleteAllRow(ctx context.Context, tableName []byte, row []byte, attributes map[string][]byte) (err error) {
+	return s.DeleteAllRowTs(ctx, tableName, row, math.MaxInt64, attributes)
+}
+
+func (s *Server) Increment(ctx context.Context, increment *hbase.TIncrement) (err error) {
+	return s.IncrementRows(ctx, []*hbase.TIncrement{increment})
+}
+
+func (s *Server) IncrementRows(ctx context.Context, increments []*hbase.TIncrement) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts := s.now()
+	for _, inc := range increments {
+		t, err := s.enabledTable(inc.Table)
+		if err != nil {
+			return err
+		}
+		if _, err = t.increment(inc.Row, inc.Column, inc.Ammount, ts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) DeleteAllRowTs(ctx context.Context, tableName []byte, row []byte, timestamp int64, attributes map[string][]byte) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(tableName)
+	if err != nil {
+		return err
+	}
+	t.delete(row, nil, timestamp)
+	return nil
+}
+
+func (s *Server) ScannerOpenWithScan(ctx context.Context, tableName []byte, scan *hbase.TScan, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(tableName)
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range scan.Columns {
+		if err = t.checkFamily(c); err != nil {
+			return 0, err
+		}
+	}
+	f, err := parseFilter(string(scan.FilterString))
+	if err != nil {
+		return 0, err
+	}
+	before := int64(math.MaxInt64)
+	if scan.Timestamp != nil {
+		before = *scan.Timestamp
+	}
+	reversed := scan.Reversed != nil && *scan.Reversed
+	sorted := scan.SortColumns != nil && *scan.SortColumns
+
+	sc := &scanner{}
+	for _, key := range t.scanKeys(scan.StartRow, scan.StopRow, reversed) {
+		if res := t.rowResult(key, scan.Columns, before, sorted); res != nil {
+			if res = f.apply(res); res != nil {
+				sc.rows = append(sc.rows, res)
+			}
+		}
+	}
+	s.nextID++
+	s.scanners[s.nextID] = sc
+	return s.nextID, nil
+}
+
+// scanKeys returns the row keys in scan order. For reversed scans start is
+// the inclusive upper bound and stop the exclusive lower bound.
+func (t *table) scanKeys(start, stop []byte, reversed bool) []string {
+	keys := make([]string, 0, len(t.rows))
+	for key := range t.rows {
+		k := []byte(key)
+		if reversed {
+			if len(start) > 0 && bytes.Compare(k, start) > 0 {
+				continue
+			}
+			if len(stop) > 0 && bytes.Compare(k, stop) <= 0 {
+				continue
+			}
+		} else {
+			if len(start) > 0 && bytes.Compare(k, start) < 0 {
+				continue
+			}
+			if len(stop) > 0 && bytes.Compare(k, stop) >= 0 {
+				continue
+			}
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if reversed {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+	return keys
+}
+
+func (s *Server) ScannerOpen(ctx context.Context, tableName []byte, startRow []byte, columns [][]byte, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	return s.ScannerOpenWithScan(ctx, tableName, &hbase.TScan{StartRow: startRow, Columns: columns}, attributes)
+}
+
+func (s *Server) ScannerOpenWithStop(ctx context.Context, tableName []byte, startRow []byte, stopRow []byte, columns [][]byte, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	return s.ScannerOpenWithScan(ctx, tableName, &hbase.TScan{StartRow: startRow, StopRow: stopRow, Columns: columns}, attributes)
+}
+
+func (s *Server) ScannerOpenWithPrefix(ctx context.Context, tableName []byte, startAndPrefix []byte, columns [][]byte, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	filter := fmt.Sprintf("PrefixFilter('%s')", strings.Replace(string(startAndPrefix), "'", "''", -1))
+	return s.ScannerOpenWithScan(ctx, tableName, &hbase.TScan{StartRow: startAndPrefix, Columns: columns, FilterString: []byte(filter)}, attributes)
+}
+
+func (s *Server) ScannerOpenTs(ctx context.Context, tableName []byte, startRow []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	return s.ScannerOpenWithScan(ctx, tableName, &hbase.TScan{StartRow: startRow, Columns: columns, Timestamp: &timestamp}, attributes)
+}
+
+func (s *Server) ScannerOpenWithStopTs(ctx context.Context, tableName []byte, startRow []byte, stopRow []byte, columns [][]byte, timestamp int64, attributes map[string][]byte) (r hbase.ScannerID, err error) {
+	return s.ScannerOpenWithScan(ctx, tableName, &hbase.TScan{StartRow: startRow, StopRow: stopRow, Columns: columns, Timestamp: &timestamp}, attributes)
+}
+
+func (s *Server) ScannerGet(ctx context.Context, id hbase.ScannerID) (r []*hbase.TRowResult_, err error) {
+	return s.ScannerGetList(ctx, id, 1)
+}
+
+func (s *Server) ScannerGetList(ctx context.Context, id hbase.ScannerID, nbRows int32) (r []*hbase.TRowResult_, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sc, ok := s.scanners[id]
+	if !ok {
+		return nil, &hbase.IllegalArgument{Message: "scanner ID is invalid"}
+	}
+	end := sc.pos + int(nbRows)
+	if end > len(sc.rows) {
+		end = len(sc.rows)
+	}
+	r = sc.rows[sc.pos:end]
+	sc.pos = end
+	return r, nil
+}
+
+func (s *Server) ScannerClose(ctx context.Context, id hbase.ScannerID) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.scanners[id]; !ok {
+		return &hbase.IllegalArgument{Message: "scanner ID is invalid"}
+	}
+	delete(s.scanners, id)
+	return nil
+}
+
+// GetRegionInfo expects a meta row of the form "table,row,".
+func (s *Server) GetRegionInfo(ctx context.Context, row []byte) (r *hbase.TRegionInfo, err error) {
+	parts := bytes.SplitN(row, []byte(","), 2)
+	if len(parts) != 2 {
+		return nil, &hbase.IOError{Message: "invalid meta row " + string(row)}
+	}
+	key := parts[1]
+	if i := bytes.LastIndexByte(key, ','); i >= 0 {
+		key = key[:i]
+	}
+	regions, err := s.GetTableRegions(ctx, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, region := range regions {
+		if len(region.EndKey) == 0 || bytes.Compare(key, region.EndKey) < 0 {
+			return region, nil
+		}
+	}
+	return regions[len(regions)-1], nil
+}
+
+func (s *Server) Append(ctx context.Context, app *hbase.TAppend) (r []*hbase.TCell, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(app.Table)
+	if err != nil {
+		return nil, err
+	}
+	if len(app.Columns) != len(app.Values) {
+		return nil, &hbase.IOError{Message: "columns and values must have the same length"}
+	}
+	for _, c := range app.Columns {
+		if err = t.checkFamily(c); err != nil {
+			return nil, err
+		}
+	}
+	ts := s.now()
+	for i, c := range app.Columns {
+		var v []byte
+		if cell := t.latest(app.Row, c); cell != nil {
+			v = cell.Value
+		}
+		v = bytes.Join([][]byte{v, app.Values[i]}, nil)
+		t.put(app.Row, c, v, ts)
+		r = append(r, &hbase.TCell{Value: v, Timestamp: ts})
+	}
+	return r, nil
+}
+
+// CheckAndPut always applies mput as a put, like the Thrift1 gateway does.
+func (s *Server) CheckAndPut(ctx context.Context, tableName []byte, row []byte, column []byte, value []byte, mput *hbase.Mutation, attributes map[string][]byte) (r bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.enabledTable(tableName)
+	if err != nil {
+		return false, err
+	}
+	if mput == nil {
+		return false, &hbase.IllegalArgument{Message: "mput is required"}
+	}
+	if err = t.checkFamily(column); err != nil {
+		return false, err
+	}
+	if err = t.checkFamily(mput.Column); err != nil {
+		return false, err
+	}
+	// like HRegion.checkAndMutate an empty expected value matches a missing
+	// cell as well as an empty one
+	cell := t.latest(row, column)
+	if len(value) == 0 {
+		if cell != nil && len(cell.Value) > 0 {
+			return false, nil
+		}
+	} else if cell == nil || !bytes.Equal(cell.Value, value) {
+		return false, nil
+	}
+	t.put(row, mput.Column, mput.Value, s.now())
+	return true, nil
+}
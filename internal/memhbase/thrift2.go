@@ -0,0 +1,819 @@
+package memhbase
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/thrift2"
+	"github.com/apache/thrift/lib/go/thrift"
+	"math"
+	"sort"
+	"strings"
+)
+
+var _ thrift2.THBaseService = (*Thrift2Server)(nil)
+
+// Thrift2Server serves the tables of a Server through the Thrift2
+// THBaseService interface, like a thrift2 gateway running next to a thrift
+// gateway on the same cluster.
+type Thrift2Server struct {
+	s *Server
+}
+
+func (s *Server) Thrift2() *Thrift2Server {
+	return &Thrift2Server{s: s}
+}
+
+// Thrift2Client returns a thrift2.Client backed by s.
+func (s *Server) Thrift2Client() *thrift2.Client {
+	return thrift2.NewClient(NewProcessorLoopback(thrift2.NewProcessor(s.Thrift2())))
+}
+
+func ioError(msg string) *thrift2.TIOError {
+	return &thrift2.TIOError{Message: &msg}
+}
+
+// thrift2Error converts the Thrift1 exceptions of the shared table code. The
+// thrift2 gateway reports every server side failure as a TIOError.
+func thrift2Error(err error) error {
+	switch e := err.(type) {
+	case *hbase.IOError:
+		return ioError(e.Message)
+	case *hbase.IllegalArgument:
+		return ioError("java.lang.IllegalArgumentException: " + e.Message)
+	case *hbase.AlreadyExists:
+		return ioError("org.apache.hadoop.hbase.TableExistsException: " + e.Message)
+	}
+	return err
+}
+
+func fullName(name *thrift2.TTableName) string {
+	if name == nil {
+		return ""
+	}
+	if len(name.Ns) == 0 || string(name.Ns) == "default" {
+		return string(name.Qualifier)
+	}
+	return string(name.Ns) + ":" + string(name.Qualifier)
+}
+
+func toTTableName(name string) *thrift2.TTableName {
+	ns, qualifier := "default", name
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		ns, qualifier = name[:i], name[i+1:]
+	}
+	return &thrift2.TTableName{Ns: []byte(ns), Qualifier: []byte(qualifier)}
+}
+
+func column(family, qualifier []byte) []byte {
+	return bytes.Join([][]byte{family, qualifier}, []byte(":"))
+}
+
+func columns(cols []*thrift2.TColumn) [][]byte {
+	r := make([][]byte, 0, len(cols))
+	for _, c := range cols {
+		if c.Qualifier == nil {
+			r = append(r, c.Family)
+		} else {
+			r = append(r, column(c.Family, c.Qualifier))
+		}
+	}
+	return r
+}
+
+// timeRange returns the [min, max) timestamps selected by a get or scan.
+func timeRange(ts *int64, tr *thrift2.TTimeRange) (int64, int64) {
+	switch {
+	case ts != nil:
+		return *ts, *ts + 1
+	case tr != nil:
+		return tr.MinStamp, tr.MaxStamp
+	}
+	return 0, math.MaxInt64
+}
+
+// rowVersions returns every version of the row selected by the arguments as
+// sorted columns, one entry per version, newest first within a column.
+func (t *table) rowVersions(row string, columns [][]byte, min, max int64, maxVersions int32) *hbase.TRowResult_ {
+	cols, ok := t.rows[row]
+	if !ok {
+		return nil
+	}
+	if maxVersions <= 0 {
+		maxVersions = 1
+	}
+	names := make([]string, 0, len(cols))
+	for col := range cols {
+		if matchColumn(col, columns) {
+			names = append(names, col)
+		}
+	}
+	sortColumns(names)
+	r := &hbase.TRowResult_{Row: []byte(row)}
+	for _, col := range names {
+		n := int32(0)
+		for _, c := range cols[col] {
+			if n >= maxVersions {
+				break
+			}
+			if c.Timestamp >= min && c.Timestamp < max {
+				r.SortedColumns = append(r.SortedColumns, &hbase.TColumn{ColumnName: []byte(col), Cell: &hbase.TCell{Value: c.Value, Timestamp: c.Timestamp}})
+				n++
+			}
+		}
+	}
+	if r.SortedColumns == nil {
+		return nil
+	}
+	return r
+}
+
+func toTResult(r *hbase.TRowResult_) *thrift2.TResult_ {
+	res := &thrift2.TResult_{ColumnValues: []*thrift2.TColumnValue{}}
+	if r == nil {
+		return res
+	}
+	res.Row = r.Row
+	for _, c := range r.SortedColumns {
+		family, qualifier, _ := splitColumn(c.ColumnName)
+		ts := c.Cell.Timestamp
+		res.ColumnValues = append(res.ColumnValues, &thrift2.TColumnValue{
+			Family:    []byte(family),
+			Qualifier: []byte(qualifier),
+			Value:     c.Cell.Value,
+			Timestamp: &ts,
+		})
+	}
+	return res
+}
+
+func (t *table) get(get *thrift2.TGet) (*thrift2.TResult_, error) {
+	cols := columns(get.Columns)
+	for _, c := range cols {
+		if err := t.checkFamily(c); err != nil {
+			return nil, err
+		}
+	}
+	f, err := parseFilter(string(get.FilterString))
+	if err != nil {
+		return nil, err
+	}
+	min, max := timeRange(get.Timestamp, get.TimeRange)
+	r := t.rowVersions(string(get.Row), cols, min, max, get.GetMaxVersions())
+	if r != nil {
+		r = f.apply(r)
+	}
+	return toTResult(r), nil
+}
+
+func (t *table) applyPut(put *thrift2.TPut, now int64) error {
+	for _, cv := range put.ColumnValues {
+		if err := t.checkFamily(cv.Family); err != nil {
+			return err
+		}
+	}
+	for _, cv := range put.ColumnValues {
+		ts := now
+		if put.Timestamp != nil {
+			ts = *put.Timestamp
+		}
+		if cv.Timestamp != nil {
+			ts = *cv.Timestamp
+		}
+		t.put(put.Row, column(cv.Family, cv.Qualifier), cv.Value, ts)
+	}
+	return nil
+}
+
+// deleteVersion removes the version of the matching columns with timestamp
+// ts, or the newest version if ts is nil.
+func (t *table) deleteVersion(row []byte, col []byte, ts *int64) {
+	cols := t.rows[string(row)]
+	for name, versions := range cols {
+		if !matchColumn(name, [][]byte{col}) {
+			continue
+		}
+		for i, c := range versions {
+			if ts == nil || c.Timestamp == *ts {
+				versions = append(versions[:i:i], versions[i+1:]...)
+				break
+			}
+		}
+		if len(versions) == 0 {
+			delete(cols, name)
+		} else {
+			cols[name] = versions
+		}
+	}
+	if len(cols) == 0 {
+		delete(t.rows, string(row))
+	}
+}
+
+func (t *table) applyDelete(d *thrift2.TDelete) error {
+	for _, c := range d.Columns {
+		if err := t.checkFamily(c.Family); err != nil {
+			return err
+		}
+	}
+	ts := int64(math.MaxInt64)
+	if d.Timestamp != nil {
+		ts = *d.Timestamp
+	}
+	if len(d.Columns) == 0 {
+		t.delete(d.Row, nil, ts)
+		return nil
+	}
+	for _, c := range d.Columns {
+		cts, exact := ts, d.Timestamp
+		if c.Timestamp != nil {
+			cts, exact = *c.Timestamp, c.Timestamp
+		}
+		switch {
+		case c.Qualifier == nil && d.DeleteType == thrift2.TDeleteType_DELETE_FAMILY_VERSION:
+			t.deleteVersion(d.Row, c.Family, &cts)
+		case c.Qualifier == nil:
+			t.delete(d.Row, c.Family, cts)
+		case d.DeleteType == thrift2.TDeleteType_DELETE_COLUMN:
+			t.deleteVersion(d.Row, column(c.Family, c.Qualifier), exact)
+		default:
+			t.delete(d.Row, column(c.Family, c.Qualifier), cts)
+		}
+	}
+	return nil
+}
+
+// check compares the given value with the newest version of the cell, i.e.
+// LESS passes if value is less than the stored value. An empty value checks
+// for a missing or empty cell: EQUAL passes if there is none and NOT_EQUAL
+// passes if there is one.
+func (t *table) check(row, family, qualifier []byte, op thrift2.TCompareOp, value []byte) (bool, error) {
+	if err := t.checkFamily(family); err != nil {
+		return false, err
+	}
+	cell := t.latest(row, column(family, qualifier))
+	if len(value) == 0 {
+		missing := cell == nil || len(cell.Value) == 0
+		switch op {
+		case thrift2.TCompareOp_EQUAL:
+			return missing, nil
+		case thrift2.TCompareOp_NOT_EQUAL:
+			return !missing, nil
+		}
+		return false, nil
+	}
+	if cell == nil {
+		return false, nil
+	}
+	c := bytes.Compare(value, cell.Value)
+	switch op {
+	case thrift2.TCompareOp_LESS:
+		return c < 0, nil
+	case thrift2.TCompareOp_LESS_OR_EQUAL:
+		return c <= 0, nil
+	case thrift2.TCompareOp_EQUAL:
+		return c == 0, nil
+	case thrift2.TCompareOp_NOT_EQUAL:
+		return c != 0, nil
+	case thrift2.TCompareOp_GREATER_OR_EQUAL:
+		return c >= 0, nil
+	case thrift2.TCompareOp_GREATER:
+		return c > 0, nil
+	}
+	return false, nil
+}
+
+func (t *table) mutateRow(rm *thrift2.TRowMutations, now int64) error {
+	for _, m := range rm.Mutations {
+		if m.CountSetFieldsTMutation() != 1 {
+			return &hbase.IllegalArgument{Message: "a TMutation must be a put or a delete"}
+		}
+		var row []byte
+		var families [][]byte
+		if m.IsSetPut() {
+			row = m.Put.Row
+			for _, cv := range m.Put.ColumnValues {
+				families = append(families, cv.Family)
+			}
+		} else {
+			row = m.DeleteSingle.Row
+			for _, c := range m.DeleteSingle.Columns {
+				families = append(families, c.Family)
+			}
+		}
+		if !bytes.Equal(row, rm.Row) {
+			return &hbase.IOError{Message: "org.apache.hadoop.hbase.DoNotRetryIOException: mutation row does not match " + string(rm.Row)}
+		}
+		for _, family := range families {
+			if err := t.checkFamily(family); err != nil {
+				return err
+			}
+		}
+	}
+	for _, m := range rm.Mutations {
+		if m.IsSetPut() {
+			t.applyPut(m.Put, now)
+		} else {
+			t.applyDelete(m.DeleteSingle)
+		}
+	}
+	return nil
+}
+
+func (p *Thrift2Server) enabledTable(name []byte) (*table, error) {
+	t, err := p.s.enabledTable(name)
+	return t, thrift2Error(err)
+}
+
+func (p *Thrift2Server) Exists(ctx context.Context, table []byte, tget *thrift2.TGet) (r bool, err error) {
+	res, err := p.Get(ctx, table, tget)
+	if err != nil {
+		return false, err
+	}
+	return len(res.ColumnValues) > 0, nil
+}
+
+func (p *Thrift2Server) Get(ctx context.Context, table []byte, tget *thrift2.TGet) (r *thrift2.TResult_, err error) {
+	rs, err := p.GetMultiple(ctx, table, []*thrift2.TGet{tget})
+	if err != nil {
+		return nil, err
+	}
+	return rs[0], nil
+}
+
+func (p *Thrift2Server) GetMultiple(ctx context.Context, table []byte, tgets []*thrift2.TGet) (r []*thrift2.TResult_, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.enabledTable(table)
+	if err != nil {
+		return nil, err
+	}
+	r = make([]*thrift2.TResult_, 0, len(tgets))
+	for _, g := range tgets {
+		res, err := t.get(g)
+		if err != nil {
+			return nil, thrift2Error(err)
+		}
+		r = append(r, res)
+	}
+	return r, nil
+}
+
+func (p *Thrift2Server) Put(ctx context.Context, table []byte, tput *thrift2.TPut) (err error) {
+	return p.PutMultiple(ctx, table, []*thrift2.TPut{tput})
+}
+
+func (p *Thrift2Server) CheckAndPut(ctx context.Context, table []byte, row []byte, family []byte, qualifier []byte, value []byte, tput *thrift2.TPut) (r bool, err error) {
+	return p.CheckAndMutate(ctx, table, row, family, qualifier, thrift2.TCompareOp_EQUAL, value, &thrift2.TRowMutations{
+		Row:       row,
+		Mutations: []*thrift2.TMutation{{Put: tput}},
+	})
+}
+
+func (p *Thrift2Server) PutMultiple(ctx context.Context, table []byte, tputs []*thrift2.TPut) (err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.enabledTable(table)
+	if err != nil {
+		return err
+	}
+	now := p.s.now()
+	for _, put := range tputs {
+		if err = t.applyPut(put, now); err != nil {
+			return thrift2Error(err)
+		}
+	}
+	return nil
+}
+
+func (p *Thrift2Server) DeleteSingle(ctx context.Context, table []byte, tdelete *thrift2.TDelete) (err error) {
+	_, err = p.DeleteMultiple(ctx, table, []*thrift2.TDelete{tdelete})
+	return err
+}
+
+func (p *Thrift2Server) DeleteMultiple(ctx context.Context, table []byte, tdeletes []*thrift2.TDelete) (r []*thrift2.TDelete, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.enabledTable(table)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range tdeletes {
+		if err = t.applyDelete(d); err != nil {
+			return nil, thrift2Error(err)
+		}
+	}
+	return []*thrift2.TDelete{}, nil
+}
+
+func (p *Thrift2Server) CheckAndDelete(ctx context.Context, table []byte, row []byte, family []byte, qualifier []byte, value []byte, tdelete *thrift2.TDelete) (r bool, err error) {
+	return p.CheckAndMutate(ctx, table, row, family, qualifier, thrift2.TCompareOp_EQUAL, value, &thrift2.TRowMutations{
+		Row:       row,
+		Mutations: []*thrift2.TMutation{{DeleteSingle: tdelete}},
+	})
+}
+
+func (p *Thrift2Server) Increment(ctx context.Context, table []byte, tincrement *thrift2.TIncrement) (r *thrift2.TResult_, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.enabledTable(table)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range tincrement.Columns {
+		if err = t.checkFamily(c.Family); err != nil {
+			return nil, thrift2Error(err)
+		}
+	}
+	ts := p.s.now()
+	res := &hbase.TRowResult_{Row: tincrement.Row}
+	for _, c := range tincrement.Columns {
+		col := column(c.Family, c.Qualifier)
+		v, err := t.increment(tincrement.Row, col, c.Amount, ts)
+		if err != nil {
+			return nil, thrift2Error(err)
+		}
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, uint64(v))
+		res.SortedColumns = append(res.SortedColumns, &hbase.TColumn{ColumnName: col, Cell: &hbase.TCell{Value: buf, Timestamp: ts}})
+	}
+	return toTResult(res), nil
+}
+
+func (p *Thrift2Server) Append(ctx context.Context, table []byte, tappend *thrift2.TAppend) (r *thrift2.TResult_, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.enabledTable(table)
+	if err != nil {
+		return nil, err
+	}
+	for _, cv := range tappend.Columns {
+		if err = t.checkFamily(cv.Family); err != nil {
+			return nil, thrift2Error(err)
+		}
+	}
+	ts := p.s.now()
+	res := &hbase.TRowResult_{Row: tappend.Row}
+	for _, cv := range tappend.Columns {
+		col := column(cv.Family, cv.Qualifier)
+		var v []byte
+		if cell := t.latest(tappend.Row, col); cell != nil {
+			v = cell.Value
+		}
+		v = bytes.Join([][]byte{v, cv.Value}, nil)
+		t.put(tappend.Row, col, v, ts)
+		res.SortedColumns = append(res.SortedColumns, &hbase.TColumn{ColumnName: col, Cell: &hbase.TCell{Value: v, Timestamp: ts}})
+	}
+	return toTResult(res), nil
+}
+
+func (p *Thrift2Server) OpenScanner(ctx context.Context, table []byte, tscan *thrift2.TScan) (r int32, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.enabledTable(table)
+	if err != nil {
+		return 0, err
+	}
+	cols := columns(tscan.Columns)
+	for _, c := range cols {
+		if err = t.checkFamily(c); err != nil {
+			return 0, thrift2Error(err)
+		}
+	}
+	f, err := parseFilter(string(tscan.FilterString))
+	if err != nil {
+		return 0, thrift2Error(err)
+	}
+	min, max := timeRange(nil, tscan.TimeRange)
+	reversed := tscan.Reversed != nil && *tscan.Reversed
+	sc := &scanner{}
+	for _, key := range t.scanKeys(tscan.StartRow, tscan.StopRow, reversed) {
+		if tscan.Limit != nil && *tscan.Limit > 0 && len(sc.rows) >= int(*tscan.Limit) {
+			break
+		}
+		if res := t.rowVersions(key, cols, min, max, tscan.GetMaxVersions()); res != nil {
+			if res = f.apply(res); res != nil {
+				sc.rows = append(sc.rows, res)
+			}
+		}
+	}
+	p.s.nextID++
+	p.s.scanners[p.s.nextID] = sc
+	return int32(p.s.nextID), nil
+}
+
+func (p *Thrift2Server) GetScannerRows(ctx context.Context, scannerId int32, numRows int32) (r []*thrift2.TResult_, err error) {
+	rows, err := p.s.ScannerGetList(ctx, hbase.ScannerID(scannerId), numRows)
+	if err != nil {
+		return nil, &thrift2.TIllegalArgument{Message: thrift.StringPtr("Invalid scanner Id")}
+	}
+	r = make([]*thrift2.TResult_, 0, len(rows))
+	for _, row := range rows {
+		r = append(r, toTResult(row))
+	}
+	return r, nil
+}
+
+func (p *Thrift2Server) CloseScanner(ctx context.Context, scannerId int32) (err error) {
+	if err = p.s.ScannerClose(ctx, hbase.ScannerID(scannerId)); err != nil {
+		return &thrift2.TIllegalArgument{Message: thrift.StringPtr("Invalid scanner Id")}
+	}
+	return nil
+}
+
+func (p *Thrift2Server) MutateRow(ctx context.Context, table []byte, trowMutations *thrift2.TRowMutations) (err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.enabledTable(table)
+	if err != nil {
+		return err
+	}
+	return thrift2Error(t.mutateRow(trowMutations, p.s.now()))
+}
+
+func (p *Thrift2Server) GetScannerResults(ctx context.Context, table []byte, tscan *thrift2.TScan, numRows int32) (r []*thrift2.TResult_, err error) {
+	id, err := p.OpenScanner(ctx, table, tscan)
+	if err != nil {
+		return nil, err
+	}
+	defer p.CloseScanner(ctx, id)
+	return p.GetScannerRows(ctx, id, numRows)
+}
+
+func (p *Thrift2Server) CheckAndMutate(ctx context.Context, table []byte, row []byte, family []byte, qualifier []byte, compareOp thrift2.TCompareOp, value []byte, rowMutations *thrift2.TRowMutations) (r bool, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.enabledTable(table)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(rowMutations.Row, row) {
+		return false, ioError("org.apache.hadoop.hbase.DoNotRetryIOException: mutation row does not match " + string(row))
+	}
+	if ok, err := t.check(row, family, qualifier, compareOp, value); err != nil || !ok {
+		return false, thrift2Error(err)
+	}
+	if err = t.mutateRow(rowMutations, p.s.now()); err != nil {
+		return false, thrift2Error(err)
+	}
+	return true, nil
+}
+
+func (t *table) descriptor() *thrift2.TTableDescriptor {
+	d := &thrift2.TTableDescriptor{TableName: toTTableName(t.name)}
+	names := make([]string, 0, len(t.families))
+	for family := range t.families {
+		names = append(names, family)
+	}
+	sort.Strings(names)
+	for _, family := range names {
+		cd := t.families[family]
+		d.Columns = append(d.Columns, &thrift2.TColumnFamilyDescriptor{
+			Name:              []byte(family),
+			MaxVersions:       thrift.Int32Ptr(cd.MaxVersions),
+			TimeToLive:        thrift.Int32Ptr(cd.TimeToLive),
+			InMemory:          thrift.BoolPtr(cd.InMemory),
+			BlockCacheEnabled: thrift.BoolPtr(cd.BlockCacheEnabled),
+		})
+	}
+	return d
+}
+
+// columnDescriptor applies the HBase 2 defaults to the unset fields.
+func columnDescriptor(d *thrift2.TColumnFamilyDescriptor) *hbase.ColumnDescriptor {
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = column(d.Name, nil)
+	cd.MaxVersions = 1
+	cd.BlockCacheEnabled = true
+	if d.MaxVersions != nil {
+		cd.MaxVersions = *d.MaxVersions
+	}
+	if d.TimeToLive != nil {
+		cd.TimeToLive = *d.TimeToLive
+	}
+	if d.InMemory != nil {
+		cd.InMemory = *d.InMemory
+	}
+	if d.BlockCacheEnabled != nil {
+		cd.BlockCacheEnabled = *d.BlockCacheEnabled
+	}
+	return cd
+}
+
+func (p *Thrift2Server) table(name *thrift2.TTableName) (*table, error) {
+	t, err := p.s.table([]byte(fullName(name)))
+	return t, thrift2Error(err)
+}
+
+func (p *Thrift2Server) GetTableDescriptor(ctx context.Context, table *thrift2.TTableName) (r *thrift2.TTableDescriptor, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.table(table)
+	if err != nil {
+		return nil, err
+	}
+	return t.descriptor(), nil
+}
+
+func (p *Thrift2Server) GetTableDescriptors(ctx context.Context, tables []*thrift2.TTableName) (r []*thrift2.TTableDescriptor, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	for _, name := range tables {
+		t, err := p.table(name)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, t.descriptor())
+	}
+	return r, nil
+}
+
+func (p *Thrift2Server) TableExists(ctx context.Context, tableName *thrift2.TTableName) (r bool, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	_, ok := p.s.tables[fullName(tableName)]
+	return ok, nil
+}
+
+// systemTables are listed in the hbase namespace, as by a gateway, but
+// cannot be read.
+var systemTables = []string{"hbase:meta", "hbase:namespace"}
+
+func (p *Thrift2Server) GetTableNamesByNamespace(ctx context.Context, name string) (r []*thrift2.TTableName, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	if _, ok := p.s.namespaces[name]; !ok {
+		return nil, ioError("org.apache.hadoop.hbase.NamespaceNotFoundException: " + name)
+	}
+	var names []string
+	if name == "hbase" {
+		names = append(names, systemTables...)
+	}
+	for n := range p.s.tables {
+		if string(toTTableName(n).Ns) == name {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	r = make([]*thrift2.TTableName, 0, len(names))
+	for _, n := range names {
+		r = append(r, toTTableName(n))
+	}
+	return r, nil
+}
+
+func (p *Thrift2Server) CreateTable(ctx context.Context, desc *thrift2.TTableDescriptor, splitKeys [][]byte) (err error) {
+	ns := string(toTTableName(fullName(desc.TableName)).Ns)
+	p.s.mu.Lock()
+	_, ok := p.s.namespaces[ns]
+	p.s.mu.Unlock()
+	if !ok {
+		return ioError("org.apache.hadoop.hbase.NamespaceNotFoundException: " + ns)
+	}
+	cds := make([]*hbase.ColumnDescriptor, 0, len(desc.Columns))
+	for _, c := range desc.Columns {
+		cds = append(cds, columnDescriptor(c))
+	}
+	name := []byte(fullName(desc.TableName))
+	if err = p.s.CreateTable(ctx, name, cds); err != nil {
+		return thrift2Error(err)
+	}
+	if len(splitKeys) > 0 {
+		return thrift2Error(p.s.Split(name, splitKeys...))
+	}
+	return nil
+}
+
+func (p *Thrift2Server) DeleteTable(ctx context.Context, tableName *thrift2.TTableName) (err error) {
+	return thrift2Error(p.s.DeleteTable(ctx, []byte(fullName(tableName))))
+}
+
+func (p *Thrift2Server) TruncateTable(ctx context.Context, tableName *thrift2.TTableName, preserveSplits bool) (err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.table(tableName)
+	if err != nil {
+		return err
+	}
+	if t.enabled {
+		return ioError("org.apache.hadoop.hbase.TableNotDisabledException: " + t.name)
+	}
+	t.rows = make(map[string]map[string][]*hbase.TCell)
+	if !preserveSplits {
+		t.splits = nil
+	}
+	t.enabled = true
+	return nil
+}
+
+func (p *Thrift2Server) EnableTable(ctx context.Context, tableName *thrift2.TTableName) (err error) {
+	return thrift2Error(p.s.EnableTable(ctx, []byte(fullName(tableName))))
+}
+
+func (p *Thrift2Server) DisableTable(ctx context.Context, tableName *thrift2.TTableName) (err error) {
+	return thrift2Error(p.s.DisableTable(ctx, []byte(fullName(tableName))))
+}
+
+func (p *Thrift2Server) IsTableEnabled(ctx context.Context, tableName *thrift2.TTableName) (r bool, err error) {
+	r, err = p.s.IsTableEnabled(ctx, []byte(fullName(tableName)))
+	return r, thrift2Error(err)
+}
+
+func (p *Thrift2Server) AddColumnFamily(ctx context.Context, tableName *thrift2.TTableName, column *thrift2.TColumnFamilyDescriptor) (err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.table(tableName)
+	if err != nil {
+		return err
+	}
+	if _, ok := t.families[string(column.Name)]; ok {
+		return ioError("org.apache.hadoop.hbase.InvalidFamilyOperationException: Column family '" + string(column.Name) + "' already exists")
+	}
+	t.families[string(column.Name)] = columnDescriptor(column)
+	return nil
+}
+
+func (p *Thrift2Server) DeleteColumnFamily(ctx context.Context, tableName *thrift2.TTableName, column []byte) (err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.table(tableName)
+	if err != nil {
+		return err
+	}
+	if err = t.checkFamily(column); err != nil {
+		return thrift2Error(err)
+	}
+	delete(t.families, string(column))
+	for row := range t.rows {
+		t.delete([]byte(row), column, math.MaxInt64)
+	}
+	return nil
+}
+
+func (p *Thrift2Server) ModifyColumnFamily(ctx context.Context, tableName *thrift2.TTableName, column *thrift2.TColumnFamilyDescriptor) (err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	t, err := p.table(tableName)
+	if err != nil {
+		return err
+	}
+	if err = t.checkFamily(column.Name); err != nil {
+		return thrift2Error(err)
+	}
+	t.families[string(column.Name)] = columnDescriptor(column)
+	return nil
+}
+
+func (p *Thrift2Server) CreateNamespace(ctx context.Context, namespaceDesc *thrift2.TNamespaceDescriptor) (err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	if _, ok := p.s.namespaces[namespaceDesc.Name]; ok {
+		return ioError("org.apache.hadoop.hbase.NamespaceExistException: " + namespaceDesc.Name)
+	}
+	conf := make(map[string]string, len(namespaceDesc.Configuration))
+	for k, v := range namespaceDesc.Configuration {
+		conf[k] = v
+	}
+	p.s.namespaces[namespaceDesc.Name] = conf
+	return nil
+}
+
+func (p *Thrift2Server) DeleteNamespace(ctx context.Context, name string) (err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	if _, ok := p.s.namespaces[name]; !ok {
+		return ioError("org.apache.hadoop.hbase.NamespaceNotFoundException: " + name)
+	}
+	for n := range p.s.tables {
+		if string(toTTableName(n).Ns) == name {
+			return ioError("org.apache.hadoop.hbase.constraint.ConstraintException: Only empty namespaces can be removed. Namespace " + name + " has tables")
+		}
+	}
+	delete(p.s.namespaces, name)
+	return nil
+}
+
+func (p *Thrift2Server) GetNamespaceDescriptor(ctx context.Context, name string) (r *thrift2.TNamespaceDescriptor, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	conf, ok := p.s.namespaces[name]
+	if !ok {
+		return nil, ioError("org.apache.hadoop.hbase.NamespaceNotFoundException: " + name)
+	}
+	return &thrift2.TNamespaceDescriptor{Name: name, Configuration: conf}, nil
+}
+
+func (p *Thrift2Server) ListNamespaceDescriptors(ctx context.Context) (r []*thrift2.TNamespaceDescriptor, err error) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+	names := make([]string, 0, len(p.s.namespaces))
+	for name := range p.s.namespaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		r = append(r, &thrift2.TNamespaceDescriptor{Name: name, Configuration: p.s.namespaces[name]})
+	}
+	return r, nil
+}
@@ -0,0 +1,156 @@
+// Command mockgen writes the methods of hbasemock.Mock for the interface
+// hbase.Hbase, so the mock follows the interface when it is regenerated.
+//
+// Usage:
+//
+//	//go:generate go run ../internal/mockgen -in ../interface.go -out mock.go
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+var (
+	in    = flag.String("in", "interface.go", "file declaring the interface")
+	iface = flag.String("interface", "Hbase", "name of the interface")
+	pkg   = flag.String("pkg", "hbase", "package of the interface")
+	out   = flag.String("out", "mock.go", "output file")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mockgen: ")
+	flag.Parse()
+	src, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	b, err := generate(src, *iface, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the source of the mock methods of the interface name
+// declared in src, which belongs to package pkg.
+func generate(src []byte, name, pkg string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	var it *ast.InterfaceType
+	for _, d := range f.Decls {
+		if d, ok := d.(*ast.GenDecl); ok && d.Tok == token.TYPE {
+			for _, s := range d.Specs {
+				if s := s.(*ast.TypeSpec); s.Name.Name == name {
+					it, _ = s.Type.(*ast.InterfaceType)
+				}
+			}
+		}
+	}
+	if it == nil {
+		return nil, fmt.Errorf("no interface %s", name)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by mockgen. DO NOT EDIT.\n\npackage %smock\n\n", pkg)
+	fmt.Fprintf(&buf, "import (\n\t\"context\"\n\t%q\n)\n\n", "github.com/He11oLx/hbase")
+	fmt.Fprintf(&buf, "var _ %s.%s = (*Mock)(nil)\n", pkg, name)
+	for _, m := range it.Methods.List {
+		ft, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) != 1 {
+			return nil, errors.New("embedded interfaces are not supported")
+		}
+		qualify(ft, pkg)
+		if err := method(&buf, fset, m.Names[0].Name, ft); err != nil {
+			return nil, err
+		}
+	}
+	return format.Source(buf.Bytes())
+}
+
+// qualify prefixes the exported identifiers of types with pkg.
+func qualify(ft *ast.FuncType, pkg string) {
+	var fix func(e ast.Expr) ast.Expr
+	fix = func(e ast.Expr) ast.Expr {
+		switch t := e.(type) {
+		case *ast.Ident:
+			if ast.IsExported(t.Name) {
+				return &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(t.Name)}
+			}
+		case *ast.StarExpr:
+			t.X = fix(t.X)
+		case *ast.ArrayType:
+			t.Elt = fix(t.Elt)
+		case *ast.MapType:
+			t.Key, t.Value = fix(t.Key), fix(t.Value)
+		}
+		return e
+	}
+	for _, fl := range []*ast.FieldList{ft.Params, ft.Results} {
+		if fl == nil {
+			continue
+		}
+		for _, f := range fl.List {
+			f.Type = fix(f.Type)
+		}
+	}
+}
+
+func method(buf *bytes.Buffer, fset *token.FileSet, name string, ft *ast.FuncType) error {
+	expr := func(e ast.Expr) string {
+		var b bytes.Buffer
+		printer.Fprint(&b, fset, e)
+		return b.String()
+	}
+	var params, args []string
+	for i, p := range ft.Params.List {
+		for _, n := range p.Names {
+			params = append(params, n.Name+" "+expr(p.Type))
+			if i > 0 {
+				args = append(args, n.Name)
+			}
+		}
+	}
+	if len(params) == 0 || !strings.HasSuffix(params[0], "context.Context") {
+		return fmt.Errorf("%s does not take a context first", name)
+	}
+	var results []string
+	if ft.Results != nil {
+		for _, r := range ft.Results.List {
+			for _, n := range r.Names {
+				results = append(results, n.Name+" "+expr(r.Type))
+			}
+		}
+	}
+
+	fmt.Fprintf(buf, "\nfunc (m *Mock) %s(%s) (%s) {\n", name, strings.Join(params, ", "), strings.Join(results, ", "))
+	fmt.Fprintf(buf, "\tret := m.called(%q, %d%s)\n", name, len(results), prefixed(", ", args))
+	for i, r := range results {
+		fmt.Fprintf(buf, "\tret.assign(%d, &%s)\n", i, r[:strings.IndexByte(r, ' ')])
+	}
+	fmt.Fprintf(buf, "\treturn\n}\n")
+	return nil
+}
+
+func prefixed(sep string, list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+	return sep + strings.Join(list, ", ")
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const source = `package hbase
+
+import "context"
+
+type Hbase interface {
+	// Get a cell
+	Get(ctx context.Context, tableName []byte, row []byte, attributes map[string][]byte) (_r []*TCell, _err error)
+	ScannerClose(ctx context.Context, id ScannerID) (_err error)
+}
+`
+
+func TestGenerate(t *testing.T) {
+	b, err := generate([]byte(source), "Hbase", "hbase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(b)
+	for _, want := range []string{
+		"package hbasemock",
+		"var _ hbase.Hbase = (*Mock)(nil)",
+		"func (m *Mock) Get(ctx context.Context, tableName []byte, row []byte, attributes map[string][]byte) (_r []*hbase.TCell, _err error) {",
+		`ret := m.called("Get", 2, tableName, row, attributes)`,
+		"ret.assign(1, &_err)",
+		"func (m *Mock) ScannerClose(ctx context.Context, id hbase.ScannerID) (_err error) {",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+	if _, err := generate([]byte(source), "Missing", "hbase"); err == nil {
+		t.Error("wanted an error for a missing interface")
+	}
+}
@@ -0,0 +1,739 @@
+// Command thriftgen regenerates the Thrift bindings of a package. It runs the
+// Thrift compiler on an IDL file and rewrites the output with go/ast:
+//
+//   - the service name prefix is dropped, HbaseClient becomes Client,
+//     hbaseProcessorGet becomes ProcessorGet and so on,
+//   - the last response meta of the Client is guarded by a mutex, so
+//     goroutines can share a Client,
+//   - with -wrap f, Client_ returns f(p.c), so a function of the package
+//     hooks every call of the Client,
+//   - the blank lines opening or closing a block are dropped,
+//   - the output is split into types.go, errors.go, interface.go, client.go,
+//     processor.go and functions.go.
+//
+// With -bytes it also rewrites the Thrift1 bindings:
+//
+//   - Text and Bytes are replaced by []byte, { temp := X; y = temp } by
+//     { y = X } and []byte(v) by v,
+//   - p.IsSetX() is replaced by p.X != nil,
+//   - getters and IsSet methods of the structs, and the getters of argGetters
+//     for the call arguments, are dropped.
+//
+// Usage:
+//
+//	//go:generate go run ./internal/thriftgen -service Hbase -bytes Hbase.thrift
+//
+// The compiler is looked up as "thrift" unless -thrift or $THRIFT is set.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	compiler = flag.String("thrift", defaultCompiler(), "Thrift compiler")
+	service  = flag.String("service", "", "name of the service in the IDL")
+	toBytes  = flag.Bool("bytes", false, "replace Text and Bytes by []byte and drop the getters of the structs")
+	wrap     = flag.String("wrap", "", "function of the package wrapping the thrift.TClient of every call")
+	out      = flag.String("out", ".", "output directory")
+)
+
+func defaultCompiler() string {
+	if c := os.Getenv("THRIFT"); c != "" {
+		return c
+	}
+	return "thrift"
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("thriftgen: ")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: thriftgen -service name [-bytes] [-wrap func] [-thrift compiler] [-out dir] file.thrift")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 || *service == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	src, err := compile(*compiler, flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := generate(src, *service, *toBytes, *wrap)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, b := range files {
+		if err := os.WriteFile(filepath.Join(*out, name), b, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// compile runs the Thrift compiler on idl and returns the generated source.
+func compile(compiler, idl string) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "thriftgen")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := exec.Command(compiler, "--gen", "go:skip_remote", "-out", dir, idl)
+	cmd.Stdout, cmd.Stderr = os.Stderr, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s: %v", compiler, err)
+	}
+
+	var src []byte
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		switch name := filepath.Base(path); {
+		case name == "GoUnusedProtection__.go":
+		case strings.HasSuffix(name, "-consts.go"):
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if bytes.Contains(b, []byte("\nconst ")) || !bytes.Contains(b, []byte("func init() {\n}")) {
+				return fmt.Errorf("%s: constants are not supported", name)
+			}
+		case src != nil:
+			return errors.New("more than one generated file")
+		default:
+			src, err = os.ReadFile(path)
+		}
+		return err
+	})
+	if err == nil && src == nil {
+		err = errors.New("no generated file")
+	}
+	return src, err
+}
+
+// The getters README.md drops from the call arguments.
+var argGetters = map[string]bool{
+	"GetColumns": true, "GetValues": true, "GetAttributes": true, "GetRow": true,
+	"GetTableName": true, "GetTable": true, "GetRows": true, "GetColumn": true,
+	"GetTimestamp": true, "GetNumVersions": true, "GetMutations": true, "GetValue": true,
+}
+
+type generator struct {
+	fset    *token.FileSet
+	file    *ast.File
+	service string
+	version string
+
+	types      map[string]bool
+	exceptions map[string]bool
+	functions  map[string]bool
+}
+
+// generate rewrites the compiler output src and returns the files of the
+// package by name.
+func generate(src []byte, service string, toBytes bool, wrap string) (map[string][]byte, error) {
+	g := &generator{fset: token.NewFileSet(), service: service, version: "0.19.0"}
+	if m := regexp.MustCompile(`Thrift Compiler \(([^)]+)\)`).FindSubmatch(src); m != nil {
+		g.version = string(m[1])
+	}
+	f, err := parser.ParseFile(g.fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	g.file = f
+
+	g.rename()
+	if err := g.lockMeta(); err != nil {
+		return nil, err
+	}
+	if wrap != "" {
+		if err := g.wrapClient(wrap); err != nil {
+			return nil, err
+		}
+	}
+	if toBytes {
+		g.replaceBytes()
+		g.replaceIsSet()
+	}
+	if err := g.collect(); err != nil {
+		return nil, err
+	}
+	if toBytes {
+		g.dropGetters()
+	}
+	return g.split()
+}
+
+// rename drops the service name prefix of the identifiers and the struct
+// names in string literals.
+func (g *generator) rename() {
+	lower := strings.ToLower(g.service[:1]) + g.service[1:]
+	upperAt := func(s string, i int) bool {
+		return len(s) > i && s[i] >= 'A' && s[i] <= 'Z'
+	}
+	prefix := regexp.MustCompile(`\b` + g.service + `([A-Z])`)
+	ast.Inspect(g.file, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			lit.Value = prefix.ReplaceAllString(lit.Value, "$1")
+		}
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		switch name := id.Name; {
+		case strings.HasPrefix(name, "New"+g.service) && upperAt(name, len("New"+g.service)):
+			id.Name = "New" + name[len("New"+g.service):]
+		case strings.HasPrefix(name, g.service) && upperAt(name, len(g.service)):
+			id.Name = name[len(g.service):]
+		case strings.HasPrefix(name, lower+"Processor"):
+			id.Name = name[len(lower):]
+		}
+		return true
+	})
+}
+
+const lockedMeta = `package p
+
+type Client struct {
+	c    thrift.TClient
+	mu   sync.Mutex
+	meta thrift.ResponseMeta
+}
+
+func (p *Client) LastResponseMeta_() thrift.ResponseMeta {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.meta
+}
+
+func (p *Client) SetLastResponseMeta_(meta thrift.ResponseMeta) {
+	p.mu.Lock()
+	p.meta = meta
+	p.mu.Unlock()
+}
+`
+
+// lockMeta guards the last response meta of the Client with a mutex, the
+// compiler output races when goroutines share a Client over a pool.
+func (g *generator) lockMeta() error {
+	f, err := parser.ParseFile(g.fset, "", lockedMeta, 0)
+	if err != nil {
+		return err
+	}
+	locked := make(map[string]ast.Decl)
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.GenDecl:
+			locked["Client"] = d
+		case *ast.FuncDecl:
+			locked[d.Name.Name] = d
+		}
+	}
+	for i, d := range g.file.Decls {
+		var name string
+		switch d := d.(type) {
+		case *ast.GenDecl:
+			if s, ok := d.Specs[0].(*ast.TypeSpec); ok && d.Tok == token.TYPE {
+				name = s.Name.Name
+			}
+		case *ast.FuncDecl:
+			if d.Recv != nil && recvType(d) == "Client" {
+				name = d.Name.Name
+			}
+		}
+		if l, ok := locked[name]; ok {
+			g.file.Decls[i] = l
+			delete(locked, name)
+		}
+	}
+	if len(locked) != 0 {
+		return errors.New("unexpected Client declarations")
+	}
+	return nil
+}
+
+// wrapClient makes Client_ return wrap(p.c).
+func (g *generator) wrapClient(wrap string) error {
+	for _, d := range g.file.Decls {
+		if d, ok := d.(*ast.FuncDecl); ok && d.Recv != nil && recvType(d) == "Client" && d.Name.Name == "Client_" {
+			ret, ok := d.Body.List[len(d.Body.List)-1].(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				return errors.New("unexpected Client_ body")
+			}
+			ret.Results[0] = &ast.CallExpr{Fun: ast.NewIdent(wrap), Args: []ast.Expr{ret.Results[0]}}
+			return nil
+		}
+	}
+	return errors.New("no Client_ method")
+}
+
+var (
+	exprType  = reflect.TypeOf((*ast.Expr)(nil)).Elem()
+	exprsType = reflect.TypeOf([]ast.Expr(nil))
+)
+
+// rewrite replaces every expression e below root by f(e).
+func rewrite(root ast.Node, f func(ast.Expr) ast.Expr) {
+	set := func(v reflect.Value) {
+		e := f(v.Interface().(ast.Expr))
+		v.Set(reflect.ValueOf(&e).Elem())
+	}
+	ast.Inspect(root, func(n ast.Node) bool {
+		v := reflect.ValueOf(n)
+		if !v.IsValid() || v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+			return true
+		}
+		v = v.Elem()
+		for i := 0; i < v.NumField(); i++ {
+			switch fv := v.Field(i); fv.Type() {
+			case exprType:
+				if !fv.IsNil() {
+					set(fv)
+				}
+			case exprsType:
+				for j := 0; j < fv.Len(); j++ {
+					set(fv.Index(j))
+				}
+			}
+		}
+		return true
+	})
+}
+
+func isByteSlice(e ast.Expr) bool {
+	a, ok := e.(*ast.ArrayType)
+	if !ok || a.Len != nil {
+		return false
+	}
+	id, ok := a.Elt.(*ast.Ident)
+	return ok && id.Name == "byte"
+}
+
+// replaceBytes replaces the Text and Bytes typedefs by []byte, assigns the
+// values read to the fields without a temporary and drops the conversions
+// []byte(v).
+func (g *generator) replaceBytes() {
+	typedefs := map[string]bool{"Text": true, "Bytes": true}
+	decls := g.file.Decls[:0]
+	for _, d := range g.file.Decls {
+		switch d := d.(type) {
+		case *ast.GenDecl:
+			if d.Tok == token.TYPE && typedefs[d.Specs[0].(*ast.TypeSpec).Name.Name] {
+				continue
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil && typedefs[strings.TrimSuffix(d.Name.Name, "Ptr")] {
+				continue
+			}
+		}
+		decls = append(decls, d)
+	}
+	g.file.Decls = decls
+
+	rewrite(g.file, func(e ast.Expr) ast.Expr {
+		if id, ok := e.(*ast.Ident); ok && typedefs[id.Name] {
+			return &ast.ArrayType{Elt: ast.NewIdent("byte")}
+		}
+		return e
+	})
+
+	// { temp := X; y = temp } -> { y = X }
+	ast.Inspect(g.file, func(n ast.Node) bool {
+		b, ok := n.(*ast.BlockStmt)
+		if !ok {
+			return true
+		}
+		for i := 0; i+1 < len(b.List); i++ {
+			def, ok1 := b.List[i].(*ast.AssignStmt)
+			use, ok2 := b.List[i+1].(*ast.AssignStmt)
+			if !ok1 || !ok2 || def.Tok != token.DEFINE || use.Tok != token.ASSIGN || len(def.Lhs) != 1 || len(use.Rhs) != 1 {
+				continue
+			}
+			if lhs, ok := def.Lhs[0].(*ast.Ident); !ok || lhs.Name != "temp" {
+				continue
+			}
+			if rhs, ok := use.Rhs[0].(*ast.Ident); !ok || rhs.Name != "temp" {
+				continue
+			}
+			use.Rhs[0] = def.Rhs[0]
+			b.List = append(b.List[:i], b.List[i+1:]...)
+		}
+		return true
+	})
+
+	rewrite(g.file, func(e ast.Expr) ast.Expr {
+		if c, ok := e.(*ast.CallExpr); ok && len(c.Args) == 1 && isByteSlice(c.Fun) {
+			if v, ok := c.Args[0].(*ast.Ident); ok && v.Name == "v" {
+				return v
+			}
+		}
+		return e
+	})
+}
+
+// isSetField returns X if d is a method IsSetX() returning p.X != nil.
+func isSetField(d *ast.FuncDecl) (string, bool) {
+	if d.Recv == nil || !strings.HasPrefix(d.Name.Name, "IsSet") || len(d.Body.List) != 1 {
+		return "", false
+	}
+	ret, ok := d.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+	cmp, ok := ret.Results[0].(*ast.BinaryExpr)
+	if !ok || cmp.Op != token.NEQ {
+		return "", false
+	}
+	sel, ok := cmp.X.(*ast.SelectorExpr)
+	if nilIdent, _ := cmp.Y.(*ast.Ident); !ok || nilIdent == nil || nilIdent.Name != "nil" {
+		return "", false
+	}
+	field := strings.TrimPrefix(d.Name.Name, "IsSet")
+	return field, sel.Sel.Name == field
+}
+
+// replaceIsSet replaces p.IsSetX() by p.X != nil and !p.IsSetX() by
+// p.X == nil wherever every IsSetX method is such a comparison.
+func (g *generator) replaceIsSet() {
+	nilChecks := make(map[string]bool)
+	for _, d := range g.file.Decls {
+		if d, ok := d.(*ast.FuncDecl); ok && strings.HasPrefix(d.Name.Name, "IsSet") && d.Recv != nil {
+			_, ok := isSetField(d)
+			if prev, seen := nilChecks[d.Name.Name]; !seen || prev {
+				nilChecks[d.Name.Name] = ok
+			}
+		}
+	}
+	field := func(e ast.Expr) *ast.SelectorExpr {
+		c, ok := e.(*ast.CallExpr)
+		if !ok || len(c.Args) != 0 {
+			return nil
+		}
+		sel, ok := c.Fun.(*ast.SelectorExpr)
+		if !ok || !nilChecks[sel.Sel.Name] {
+			return nil
+		}
+		if p, ok := sel.X.(*ast.Ident); !ok || p.Name != "p" {
+			return nil
+		}
+		return &ast.SelectorExpr{X: sel.X, Sel: ast.NewIdent(strings.TrimPrefix(sel.Sel.Name, "IsSet"))}
+	}
+	rewrite(g.file, func(e ast.Expr) ast.Expr {
+		if u, ok := e.(*ast.UnaryExpr); ok && u.Op == token.NOT {
+			if x := field(u.X); x != nil {
+				return &ast.BinaryExpr{X: x, Op: token.EQL, Y: ast.NewIdent("nil")}
+			}
+		}
+		if x := field(e); x != nil {
+			return &ast.BinaryExpr{X: x, Op: token.NEQ, Y: ast.NewIdent("nil")}
+		}
+		return e
+	})
+}
+
+func recvType(d *ast.FuncDecl) string {
+	t := d.Recv.List[0].Type
+	if s, ok := t.(*ast.StarExpr); ok {
+		t = s.X
+	}
+	return t.(*ast.Ident).Name
+}
+
+// collect records the declared types, the exceptions and the argument and
+// result structs of the service.
+func (g *generator) collect() error {
+	g.types = make(map[string]bool)
+	g.exceptions = make(map[string]bool)
+	g.functions = make(map[string]bool)
+	var iface *ast.InterfaceType
+	for _, d := range g.file.Decls {
+		switch d := d.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range d.Specs {
+				s := s.(*ast.TypeSpec)
+				g.types[s.Name.Name] = true
+				if it, ok := s.Type.(*ast.InterfaceType); ok && s.Name.Name == g.service {
+					iface = it
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv != nil && d.Name.Name == "Error" {
+				g.exceptions[recvType(d)] = true
+			}
+		}
+	}
+	if iface == nil {
+		return fmt.Errorf("no service %s", g.service)
+	}
+	for _, m := range iface.Methods.List {
+		for _, name := range m.Names {
+			g.functions[name.Name+"Args"] = true
+			g.functions[name.Name+"Result"] = true
+		}
+	}
+	return nil
+}
+
+// dropGetters drops the getters and IsSet methods of the structs with the
+// defaults they return, and the getters README.md lists of the call
+// arguments.
+func (g *generator) dropGetters() {
+	decls := g.file.Decls[:0]
+	for _, d := range g.file.Decls {
+		if d, ok := d.(*ast.FuncDecl); ok && d.Recv != nil && d.Type.Params.NumFields() == 0 {
+			name, owner := d.Name.Name, recvType(d)
+			if g.fileOf(owner) == "types.go" || g.fileOf(owner) == "errors.go" {
+				if strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "IsSet") {
+					continue
+				}
+			} else if g.functions[owner] && argGetters[name] {
+				continue
+			}
+		}
+		decls = append(decls, d)
+	}
+	g.file.Decls = decls
+
+	used := make(map[string]bool)
+	for _, d := range g.file.Decls {
+		ast.Inspect(d, func(n ast.Node) bool {
+			if v, ok := n.(*ast.ValueSpec); ok {
+				for _, v := range v.Values {
+					ast.Inspect(v, func(n ast.Node) bool {
+						if id, ok := n.(*ast.Ident); ok {
+							used[id.Name] = true
+						}
+						return true
+					})
+				}
+				return false
+			}
+			if id, ok := n.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+			return true
+		})
+	}
+	decls = g.file.Decls[:0]
+	for _, d := range g.file.Decls {
+		if d, ok := d.(*ast.GenDecl); ok && d.Tok == token.VAR && len(d.Specs) == 1 {
+			name := d.Specs[0].(*ast.ValueSpec).Names[0].Name
+			if file := g.fileOf(g.owner(d)); strings.HasSuffix(name, "_DEFAULT") && !used[name] && (file == "types.go" || file == "errors.go") {
+				continue
+			}
+		}
+		decls = append(decls, d)
+	}
+	g.file.Decls = decls
+}
+
+// owner returns the type a declaration belongs to: the receiver of a method,
+// else the longest type name its name starts with, e.g. TCell for NewTCell
+// and TDeleteType for TDeleteType_DELETE_COLUMN.
+func (g *generator) owner(d ast.Decl) string {
+	var name string
+	switch d := d.(type) {
+	case *ast.FuncDecl:
+		if d.Recv != nil {
+			return recvType(d)
+		}
+		name = strings.TrimPrefix(d.Name.Name, "New")
+	case *ast.GenDecl:
+		switch s := d.Specs[0].(type) {
+		case *ast.TypeSpec:
+			return s.Name.Name
+		case *ast.ValueSpec:
+			name = s.Names[0].Name
+			if name == "_" {
+				// var _ thrift.TException = (*IOError)(nil)
+				ast.Inspect(s.Values[0], func(n ast.Node) bool {
+					if id, ok := n.(*ast.Ident); ok && g.types[id.Name] && name == "_" {
+						name = id.Name
+					}
+					return true
+				})
+			}
+		}
+	}
+	owner := ""
+	for t := range g.types {
+		if strings.HasPrefix(name, t) && len(t) > len(owner) {
+			owner = t
+		}
+	}
+	return owner
+}
+
+func (g *generator) fileOf(owner string) string {
+	switch {
+	case owner == g.service:
+		return "interface.go"
+	case owner == "Client":
+		return "client.go"
+	case strings.HasPrefix(owner, "Processor"):
+		return "processor.go"
+	case g.exceptions[owner]:
+		return "errors.go"
+	case g.functions[owner]:
+		return "functions.go"
+	}
+	return "types.go"
+}
+
+// isGuard reports whether d is one of the var _ = pkg.Name declarations
+// keeping the imports in use.
+func isGuard(d ast.Decl) bool {
+	v, ok := d.(*ast.GenDecl)
+	if !ok || v.Tok != token.VAR {
+		return false
+	}
+	s := v.Specs[0].(*ast.ValueSpec)
+	return s.Names[0].Name == "_" && s.Type == nil
+}
+
+// split prints the declarations into the files they belong to.
+func (g *generator) split() (map[string][]byte, error) {
+	imports := make(map[string]string)
+	for _, s := range g.file.Imports {
+		path, _ := strconv.Unquote(s.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if s.Name != nil {
+			name = s.Name.Name
+		}
+		imports[name] = path
+	}
+	imports["sync"] = "sync"
+
+	decls := make(map[string][]ast.Decl)
+	var guards []ast.Decl
+	for _, d := range g.file.Decls {
+		if isGuard(d) {
+			guards = append(guards, d)
+			continue
+		}
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			continue
+		}
+		name := g.fileOf(g.owner(d))
+		decls[name] = append(decls[name], d)
+	}
+	decls["functions.go"] = append(guards, decls["functions.go"]...)
+
+	files := make(map[string][]byte)
+	for name, ds := range decls {
+		var body bytes.Buffer
+		used := make(map[string]bool)
+		for i, d := range ds {
+			if name == "functions.go" && i == len(guards) {
+				body.WriteString("// HELPER FUNCTIONS AND STRUCTURES\n\n")
+			}
+			if err := g.print(&body, d); err != nil {
+				return nil, err
+			}
+			ast.Inspect(d, func(n ast.Node) bool {
+				if s, ok := n.(*ast.SelectorExpr); ok {
+					if x, ok := s.X.(*ast.Ident); ok && x.Obj == nil && imports[x.Name] != "" {
+						used[imports[x.Name]] = true
+					}
+				}
+				return true
+			})
+		}
+
+		var b bytes.Buffer
+		fmt.Fprintf(&b, "// Code generated by Thrift Compiler (%s) and thriftgen. DO NOT EDIT.\n\n", g.version)
+		fmt.Fprintf(&b, "package %s\n\n", g.file.Name.Name)
+		paths := make([]string, 0, len(used))
+		for path := range used {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		if len(paths) == 1 {
+			fmt.Fprintf(&b, "import %q\n\n", paths[0])
+		} else if len(paths) > 1 {
+			b.WriteString("import (\n")
+			for _, path := range paths {
+				fmt.Fprintf(&b, "\t%q\n", path)
+			}
+			b.WriteString(")\n\n")
+		}
+		b.Write(dropBlankLines(body.Bytes()))
+
+		src, err := format.Source(b.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		files[name] = src
+	}
+	return files, nil
+}
+
+// dropBlankLines drops the blank lines opening or closing a block or a
+// list, which the compiler leaves around the fields with defaults and the
+// rewrites leave in place of the statements they remove.
+func dropBlankLines(src []byte) []byte {
+	lines := bytes.Split(src, []byte("\n"))
+	var kept [][]byte
+	for i, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 && len(kept) > 0 && i+1 < len(lines) {
+			prev := bytes.TrimSpace(kept[len(kept)-1])
+			next := bytes.TrimSpace(lines[i+1])
+			if bytes.HasSuffix(prev, []byte("{")) || bytes.HasSuffix(prev, []byte("(")) || bytes.HasSuffix(prev, []byte(",")) ||
+				bytes.HasPrefix(next, []byte("}")) || bytes.HasPrefix(next, []byte(")")) {
+				continue
+			}
+		}
+		kept = append(kept, line)
+	}
+	return bytes.Join(kept, []byte("\n"))
+}
+
+// print prints d with its doc and the comments inside it.
+func (g *generator) print(w *bytes.Buffer, d ast.Decl) error {
+	start := d.Pos()
+	switch d := d.(type) {
+	case *ast.FuncDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	}
+	var comments []*ast.CommentGroup
+	for _, c := range g.file.Comments {
+		if c.Pos() >= start && c.End() <= d.End() {
+			comments = append(comments, c)
+		}
+	}
+	if err := printer.Fprint(w, g.fset, &printer.CommentedNode{Node: d, Comments: comments}); err != nil {
+		return err
+	}
+	w.WriteString("\n\n")
+	return nil
+}
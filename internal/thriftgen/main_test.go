@@ -0,0 +1,199 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const compiled = `// Code generated by Thrift Compiler (0.19.0). DO NOT EDIT.
+
+package hbase
+
+import (
+	"context"
+	"fmt"
+	thrift "github.com/apache/thrift/lib/go/thrift"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+
+type Text []byte
+
+func TextPtr(v Text) *Text { return &v }
+
+// Attributes:
+//  - Row
+//  - Caching
+type TScan struct {
+  Row Text
+  Caching *int32
+}
+
+var TScan_Caching_DEFAULT int32
+func (p *TScan) GetCaching() int32 {
+  if !p.IsSetCaching() {
+    return TScan_Caching_DEFAULT
+  }
+return *p.Caching
+}
+func (p *TScan) IsSetCaching() bool {
+  return p.Caching != nil
+}
+
+func (p *TScan) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(ctx); err != nil {
+  return err
+} else {
+  temp := Text(v)
+  p.Row = temp
+}
+  return nil
+}
+
+func (p *TScan) String() string {
+  if !p.IsSetCaching() {
+    return "<unset>"
+  }
+  return fmt.Sprintf("TScan(%+v)", *p)
+}
+
+type IOError struct {
+  Message string
+}
+
+func (p *IOError) GetMessage() string {
+  return p.Message
+}
+
+func (p *IOError) Error() string {
+  return p.Message
+}
+
+var _ thrift.TException = (*IOError)(nil)
+
+type Hbase interface {
+  Get(ctx context.Context, row Text) (_r *TScan, _err error)
+}
+
+type HbaseClient struct {
+  c thrift.TClient
+  meta thrift.ResponseMeta
+}
+
+func NewHbaseClient(c thrift.TClient) *HbaseClient {
+  return &HbaseClient{c: c}
+}
+
+func (p *HbaseClient) Client_() thrift.TClient {
+  return p.c
+}
+
+func (p *HbaseClient) LastResponseMeta_() thrift.ResponseMeta {
+  return p.meta
+}
+
+func (p *HbaseClient) SetLastResponseMeta_(meta thrift.ResponseMeta) {
+  p.meta = meta
+}
+
+type HbaseProcessor struct {
+  handler Hbase
+}
+
+type hbaseProcessorGet struct {
+  handler Hbase
+}
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+type HbaseGetArgs struct {
+  Row Text
+}
+
+func (p *HbaseGetArgs) GetRow() Text {
+  return p.Row
+}
+
+func (p *HbaseGetArgs) String() string {
+  return fmt.Sprintf("HbaseGetArgs(%+v)", *p)
+}
+
+type HbaseGetResult struct {
+  Success *TScan
+}
+
+var HbaseGetResult_Success_DEFAULT *TScan
+func (p *HbaseGetResult) GetSuccess() *TScan {
+  return p.Success
+}
+func (p *HbaseGetResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+`
+
+func TestGenerate(t *testing.T) {
+	files, err := generate([]byte(compiled), "Hbase", true, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string][]string{
+		"types.go": {
+			"// Code generated by Thrift Compiler (0.19.0) and thriftgen. DO NOT EDIT.",
+			"import (\n\t\"context\"\n\t\"fmt\"\n\t\"github.com/apache/thrift/lib/go/thrift\"\n)",
+			"Row     []byte",
+			"p.Row = v\n",
+			"if p.Caching == nil {",
+		},
+		"errors.go":    {"type IOError struct", "var _ thrift.TException = (*IOError)(nil)"},
+		"interface.go": {"import \"context\"", "Get(ctx context.Context, row []byte) (_r *TScan, _err error)"},
+		"client.go":    {"type Client struct", "mu   sync.Mutex", "func NewClient(c thrift.TClient) *Client", "p.mu.Lock()"},
+		"processor.go": {"type Processor struct", "type ProcessorGet struct"},
+		"functions.go": {
+			"var _ = thrift.ZERO",
+			"// HELPER FUNCTIONS AND STRUCTURES\n\ntype GetArgs struct",
+			"fmt.Sprintf(\"GetArgs(%+v)\", *p)",
+			"var GetResult_Success_DEFAULT *TScan",
+			"func (p *GetResult) GetSuccess() *TScan",
+			"func (p *GetResult) IsSetSuccess() bool",
+		},
+	} {
+		src := string(files[name])
+		for _, w := range want {
+			if !strings.Contains(src, w) {
+				t.Errorf("%s does not contain %q:\n%s", name, w, src)
+			}
+		}
+	}
+	for name, unwanted := range map[string][]string{
+		"types.go":     {"TextPtr", "Text", "temp", "GetCaching", "IsSetCaching", "TScan_Caching_DEFAULT"},
+		"errors.go":    {"GetMessage"},
+		"functions.go": {"GetRow()", "Hbase"},
+	} {
+		src := string(files[name])
+		for _, w := range unwanted {
+			if strings.Contains(src, w) {
+				t.Errorf("%s contains %q:\n%s", name, w, src)
+			}
+		}
+	}
+	blank := regexp.MustCompile(`[{(,]\n\s*\n|\n\s*\n\s*[})]`)
+	for name, src := range files {
+		if loc := blank.FindIndex(src); loc != nil {
+			t.Errorf("%s has a blank line opening or closing a block: %q", name, src[loc[0]:loc[1]])
+		}
+	}
+}
+
+func TestGenerate_Wrap(t *testing.T) {
+	files, err := generate([]byte(compiled), "Hbase", true, "withContext")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src := string(files["client.go"]); !strings.Contains(src, "return withContext(p.c)") {
+		t.Errorf("client.go does not wrap the TClient:\n%s", src)
+	}
+}
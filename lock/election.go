@@ -0,0 +1,71 @@
+package lock
+
+import (
+	"context"
+	"time"
+)
+
+// Election elects a single leader among the candidates sharing a name.
+type Election struct {
+	l    *Locker
+	name string
+	id   string
+	ttl  time.Duration
+}
+
+func NewElection(l *Locker, name, id string, ttl time.Duration) *Election {
+	return &Election{l: l, name: name, id: id, ttl: ttl}
+}
+
+// Leader returns the id of the current leader, or "" if there is none.
+func (e *Election) Leader(ctx context.Context) (string, error) {
+	return e.l.Owner(ctx, e.name)
+}
+
+// Campaign blocks until this candidate becomes leader or ctx is done.
+func (e *Election) Campaign(ctx context.Context) (*Lock, error) {
+	return e.l.Acquire(ctx, e.name, e.id, e.ttl, e.ttl/3)
+}
+
+// Run campaigns and then calls fn while leading. The context passed to fn is
+// cancelled as soon as the lease cannot be renewed; leadership is released
+// when fn returns. Run returns ErrLockLost if leadership was lost before fn
+// returned.
+func (e *Election) Run(ctx context.Context, fn func(ctx context.Context)) error {
+	lk, err := e.Campaign(ctx)
+	if err != nil {
+		return err
+	}
+	leadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lost := make(chan error, 1)
+	go func() {
+		ticker := time.NewTicker(e.ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-leadCtx.Done():
+				lost <- nil
+				return
+			case <-ticker.C:
+				if err := lk.Renew(leadCtx); err != nil {
+					if leadCtx.Err() != nil {
+						lost <- nil
+					} else {
+						lost <- ErrLockLost
+					}
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	fn(leadCtx)
+	cancel()
+	if err = <-lost; err != nil {
+		return err
+	}
+	return lk.Release(context.Background())
+}
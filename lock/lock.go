@@ -0,0 +1,211 @@
+// Package lock implements lease based locks on top of CheckAndPut.
+//
+// A lock is a row of the lock table. The lease cell holds the owner, the
+// expiry and the fencing token of the current holder; every state change is a
+// CheckAndPut against the exact lease value that was read, so two clients can
+// never both believe they hold the lock unless their clocks disagree by more
+// than the lease TTL. Fencing tokens come from AtomicIncrement on a second
+// cell of the same row and increase with every acquisition, so a resource
+// guarded by the lock can reject writes from a holder whose lease expired.
+package lock
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"github.com/He11oLx/hbase"
+	"time"
+)
+
+var DefaultFamily = "l"
+
+var (
+	ErrLocked   = errors.New("lock: held by another owner")
+	ErrLockLost = errors.New("lock: lease lost")
+	ErrInvalid  = errors.New("lock: malformed lease")
+)
+
+type Locker struct {
+	c     hbase.Hbase
+	table []byte
+	lease []byte
+	fence []byte
+	now   func() time.Time
+}
+
+// NewLocker returns a Locker using table, which must have the column family
+// DefaultFamily.
+func NewLocker(c hbase.Hbase, table string) *Locker {
+	return NewLockerFamily(c, table, DefaultFamily)
+}
+
+func NewLockerFamily(c hbase.Hbase, table, family string) *Locker {
+	return &Locker{
+		c:     c,
+		table: []byte(table),
+		lease: []byte(family + ":lease"),
+		fence: []byte(family + ":fence"),
+		now:   time.Now,
+	}
+}
+
+type lease struct {
+	expiry int64 // unix milliseconds
+	token  int64
+	owner  string
+}
+
+func (l *lease) encode() []byte {
+	b := make([]byte, 16+len(l.owner))
+	binary.BigEndian.PutUint64(b, uint64(l.expiry))
+	binary.BigEndian.PutUint64(b[8:], uint64(l.token))
+	copy(b[16:], l.owner)
+	return b
+}
+
+func decodeLease(b []byte) (*lease, error) {
+	if len(b) < 16 {
+		return nil, ErrInvalid
+	}
+	return &lease{
+		expiry: int64(binary.BigEndian.Uint64(b)),
+		token:  int64(binary.BigEndian.Uint64(b[8:])),
+		owner:  string(b[16:]),
+	}, nil
+}
+
+func millis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// current returns the raw lease value; nil or empty means the lock is free.
+func (l *Locker) current(ctx context.Context, name []byte) ([]byte, error) {
+	cells, err := l.c.Get(ctx, l.table, name, l.lease, nil)
+	if err != nil || len(cells) == 0 {
+		return nil, err
+	}
+	return cells[0].Value, nil
+}
+
+// TryAcquire takes the lock name for owner if it is free or its lease has
+// expired. It returns ErrLocked otherwise.
+func (l *Locker) TryAcquire(ctx context.Context, name, owner string, ttl time.Duration) (*Lock, error) {
+	row := []byte(name)
+	cur, err := l.current(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if len(cur) > 0 {
+		held, err := decodeLease(cur)
+		if err != nil {
+			return nil, err
+		}
+		if held.expiry > millis(l.now()) {
+			return nil, ErrLocked
+		}
+	}
+	token, err := l.c.AtomicIncrement(ctx, l.table, row, l.fence, 1)
+	if err != nil {
+		return nil, err
+	}
+	ls := &lease{expiry: millis(l.now().Add(ttl)), token: token, owner: owner}
+	val := ls.encode()
+	ok, err := l.c.CheckAndPut(ctx, l.table, row, l.lease, cur, &hbase.Mutation{Column: l.lease, Value: val, WriteToWAL: true}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrLocked
+	}
+	return &Lock{l: l, name: row, ttl: ttl, lease: ls, value: val}, nil
+}
+
+// Acquire retries TryAcquire every interval until it succeeds or ctx is done.
+func (l *Locker) Acquire(ctx context.Context, name, owner string, ttl, interval time.Duration) (*Lock, error) {
+	for {
+		lk, err := l.TryAcquire(ctx, name, owner, ttl)
+		if err != ErrLocked {
+			return lk, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Owner returns the owner of an unexpired lease on name, or "" if the lock is
+// free.
+func (l *Locker) Owner(ctx context.Context, name string) (string, error) {
+	cur, err := l.current(ctx, []byte(name))
+	if err != nil || len(cur) == 0 {
+		return "", err
+	}
+	held, err := decodeLease(cur)
+	if err != nil {
+		return "", err
+	}
+	if held.expiry <= millis(l.now()) {
+		return "", nil
+	}
+	return held.owner, nil
+}
+
+// Lock is a held lease. It is not safe for concurrent use.
+type Lock struct {
+	l     *Locker
+	name  []byte
+	ttl   time.Duration
+	lease *lease
+	// the lease cell exactly as written, used as the expected value
+	value []byte
+}
+
+func (k *Lock) Name() string {
+	return string(k.name)
+}
+
+func (k *Lock) Owner() string {
+	return k.lease.owner
+}
+
+// Token is the fencing token of this acquisition.
+func (k *Lock) Token() int64 {
+	return k.lease.token
+}
+
+func (k *Lock) Expiry() time.Time {
+	return time.Unix(0, k.lease.expiry*int64(time.Millisecond))
+}
+
+// Renew extends the lease by its TTL. It returns ErrLockLost if the lease
+// was taken over in the meantime.
+func (k *Lock) Renew(ctx context.Context) error {
+	ls := *k.lease
+	ls.expiry = millis(k.l.now().Add(k.ttl))
+	val := ls.encode()
+	ok, err := k.l.c.CheckAndPut(ctx, k.l.table, k.name, k.l.lease, k.value, &hbase.Mutation{Column: k.l.lease, Value: val, WriteToWAL: true}, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrLockLost
+	}
+	k.lease, k.value = &ls, val
+	return nil
+}
+
+// Release gives the lock up. Thrift1 has no checkAndDelete, so the lease cell
+// is compare-and-set to an empty value, which HBase treats like a missing
+// cell in later checks.
+func (k *Lock) Release(ctx context.Context) error {
+	ok, err := k.l.c.CheckAndPut(ctx, k.l.table, k.name, k.l.lease, k.value, &hbase.Mutation{Column: k.l.lease, Value: []byte{}, WriteToWAL: true}, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrLockLost
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package lock
+
+import (
+	"context"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"testing"
+	"time"
+)
+
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time { return c.t }
+
+func newLocker(t *testing.T) (*Locker, *clock) {
+	c := &clock{t: time.Unix(1500000000, 0)}
+	l := NewLocker(memhbase.NewTable(t, "locks", DefaultFamily), "locks")
+	l.now = c.now
+	return l, c
+}
+
+func TestLocker(t *testing.T) {
+	ctx := context.Background()
+	l, c := newLocker(t)
+
+	a, err := l.TryAcquire(ctx, "job", "a", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = l.TryAcquire(ctx, "job", "b", time.Minute); err != ErrLocked {
+		t.Fatalf("wanted ErrLocked, got %v", err)
+	}
+	if owner, _ := l.Owner(ctx, "job"); owner != "a" {
+		t.Fatalf("owner %q", owner)
+	}
+
+	c.t = c.t.Add(30 * time.Second)
+	if err = a.Renew(ctx); err != nil {
+		t.Fatal(err)
+	}
+	c.t = c.t.Add(45 * time.Second)
+	if _, err = l.TryAcquire(ctx, "job", "b", time.Minute); err != ErrLocked {
+		t.Fatalf("renewed lease: wanted ErrLocked, got %v", err)
+	}
+
+	// a stalls past its lease, b takes over with a larger fencing token
+	c.t = c.t.Add(time.Minute)
+	b, err := l.TryAcquire(ctx, "job", "b", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Token() <= a.Token() {
+		t.Fatalf("fencing token did not increase: %d <= %d", b.Token(), a.Token())
+	}
+	if err = a.Renew(ctx); err != ErrLockLost {
+		t.Fatalf("wanted ErrLockLost, got %v", err)
+	}
+	if err = a.Release(ctx); err != ErrLockLost {
+		t.Fatalf("wanted ErrLockLost, got %v", err)
+	}
+
+	if err = b.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if owner, _ := l.Owner(ctx, "job"); owner != "" {
+		t.Fatalf("released lock owned by %q", owner)
+	}
+	if _, err = l.TryAcquire(ctx, "job", "a", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestElection_Run(t *testing.T) {
+	l, _ := newLocker(t)
+	l.now = time.Now
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	leading := make(chan string, 2)
+	done := make(chan error, 2)
+	release := make(chan struct{})
+	for _, id := range []string{"a", "b"} {
+		e := NewElection(l, "leader", id, 90*time.Millisecond)
+		go func(id string) {
+			done <- e.Run(ctx, func(ctx context.Context) {
+				leading <- id
+				select {
+				case <-release:
+				case <-ctx.Done():
+				}
+			})
+		}(id)
+	}
+
+	first := <-leading
+	select {
+	case id := <-leading:
+		t.Fatalf("%s and %s lead at the same time", first, id)
+	case <-time.After(300 * time.Millisecond):
+	}
+	if leader, _ := NewElection(l, "leader", "", time.Second).Leader(ctx); leader != first {
+		t.Fatalf("leader %q, wanted %q", leader, first)
+	}
+	release <- struct{}{}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	second := <-leading
+	if second == first {
+		t.Fatalf("%s lead twice", first)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,8 +2,12 @@ package pool
 
 import (
 	"context"
-	"git.apache.org/thrift.git/lib/go/thrift"
+	"crypto/tls"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/sasl"
+	"github.com/apache/thrift/lib/go/thrift"
 	"net"
+	"sync/atomic"
 	"time"
 )
 
@@ -24,11 +28,54 @@ type TPoolClient struct {
 }
 
 func NewTPoolClient(host, port string, inputProtocol, outputProtocol thrift.TProtocolFactory, initialCap, maxCap int) (*TPoolClient, error) {
-	newFunc := func() (interface{}, error) {
+	return newTPoolClient(func() (net.Conn, error) {
+		return net.DialTimeout("tcp", net.JoinHostPort(host, port), DefaultConnectTimeout)
+	}, inputProtocol, outputProtocol, initialCap, maxCap)
+}
+
+// NewTLSPoolClient is NewTPoolClient over TLS configured by options, which may
+// be nil.
+func NewTLSPoolClient(host, port string, inputProtocol, outputProtocol thrift.TProtocolFactory, options *hbase.TLSOptions, initialCap, maxCap int) (*TPoolClient, error) {
+	cfg, err := options.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.ServerName == "" {
+		cfg.ServerName = host
+	}
+	dialer := &net.Dialer{Timeout: DefaultConnectTimeout}
+	return newTPoolClient(func() (net.Conn, error) {
+		return tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(host, port), cfg)
+	}, inputProtocol, outputProtocol, initialCap, maxCap)
+}
+
+// NewSASLPoolClient is NewTPoolClient negotiating a mechanism returned by
+// mechanism on every connection, e.g.
+//
+//	func() sasl.Mechanism { return sasl.DigestMD5(user, password, "hbase", host) }
+func NewSASLPoolClient(host, port string, inputProtocol, outputProtocol thrift.TProtocolFactory, mechanism func() sasl.Mechanism, initialCap, maxCap int) (*TPoolClient, error) {
+	return newTPoolClient(func() (net.Conn, error) {
 		conn, err := net.DialTimeout("tcp", net.JoinHostPort(host, port), DefaultConnectTimeout)
 		if err != nil {
 			return nil, err
 		}
+		conn.SetDeadline(time.Now().Add(DefaultConnectTimeout))
+		c, err := sasl.Client(conn, mechanism())
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn.SetDeadline(time.Time{})
+		return c, nil
+	}, inputProtocol, outputProtocol, initialCap, maxCap)
+}
+
+func newTPoolClient(dial func() (net.Conn, error), inputProtocol, outputProtocol thrift.TProtocolFactory, initialCap, maxCap int) (*TPoolClient, error) {
+	newFunc := func() (interface{}, error) {
+		conn, err := dial()
+		if err != nil {
+			return nil, err
+		}
 		return conn, err
 	}
 	closeFunc := func(v interface{}) error { return v.(net.Conn).Close() }
@@ -55,7 +102,13 @@ func (p *TPoolClient) SetMaxRetry(maxRetry int) {
 	}
 }
 
-func (p *TPoolClient) Call(ctx context.Context, method string, args, result thrift.TStruct) (err error) {
+// Call sends a call on a pooled connection. The deadline of ctx bounds every
+// read and write, and ctx being done aborts the call by closing the
+// connection, which is not returned to the pool.
+func (p *TPoolClient) Call(ctx context.Context, method string, args, result thrift.TStruct) (meta thrift.ResponseMeta, err error) {
+	if err := ctx.Err(); err != nil {
+		return meta, thrift.NewTTransportExceptionFromError(err)
+	}
 	var connVar interface{}
 	// try old conn
 	for i := 0; i < p.maxRetry; i++ {
@@ -65,44 +118,70 @@ func (p *TPoolClient) Call(ctx context.Context, method string, args, result thri
 			continue
 		}
 		if err == nil {
-			err = p.call(connVar, method, args, result)
+			err = p.call(ctx, connVar, method, args, result)
 		}
 		switch err.(type) {
 		case nil:
 			p.pool.Put(connVar)
-			return nil
+			return meta, nil
 		case thrift.TTransportException:
 			// TTransportException 是 TProtocolException 的子集
 			p.pool.Close(connVar.(net.Conn))
-			return err
+			return meta, err
 		case thrift.TProtocolException:
 			// TProtocolException 是 TException 的子集
 			p.pool.Close(connVar.(net.Conn))
 			continue
 		default:
 			p.pool.Close(connVar.(net.Conn))
-			return err
+			return meta, err
 		}
 	}
 
 	// try new conn
 	connVar, err = p.pool.New()
 	if err != nil {
-		return err
+		return meta, err
 	}
-	return p.call(connVar, method, args, result)
+	if err = p.call(ctx, connVar, method, args, result); err != nil {
+		p.pool.Close(connVar.(net.Conn))
+		return meta, err
+	}
+	p.pool.Put(connVar)
+	return meta, nil
 }
-func (p *TPoolClient) call(connVar interface{}, method string, args, result thrift.TStruct) (err error) {
-	p.seqId++
-	seqId := p.seqId
+
+func (p *TPoolClient) call(ctx context.Context, connVar interface{}, method string, args, result thrift.TStruct) (err error) {
+	seqId := atomic.AddInt32(&p.seqId, 1)
 	conn := connVar.(net.Conn)
 
-	trans := thrift.NewTSocketFromConnTimeout(conn, p.timeout)
-	protocolFactory := thrift.NewTBinaryProtocolFactoryDefault()
+	timeout := p.timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); timeout <= 0 || remaining < timeout {
+			// a timeout of 0 would mean none
+			timeout = remaining
+			if timeout <= 0 {
+				timeout = time.Nanosecond
+			}
+		}
+	}
+	if stop := closeOnDone(ctx, conn); stop != nil {
+		defer func() {
+			if stop() {
+				err = thrift.NewTTransportExceptionFromError(ctx.Err())
+			}
+		}()
+	}
+
+	conf := &thrift.TConfiguration{ConnectTimeout: p.timeout, SocketTimeout: timeout}
+	// buffered, so that a sasl.Conn gets writes of up to 4096 bytes, each a
+	// frame, rather than a frame per field of the call
+	trans := thrift.NewTBufferedTransport(thrift.NewTSocketFromConnConf(conn, conf), 4096)
+	protocolFactory := thrift.NewTBinaryProtocolFactoryConf(conf)
 	inputProtocol := protocolFactory.GetProtocol(trans)
 	outputProtocol := protocolFactory.GetProtocol(trans)
 
-	if err = p.Send(outputProtocol, seqId, method, args); err != nil {
+	if err = p.Send(ctx, outputProtocol, seqId, method, args); err != nil {
 		return err
 	}
 
@@ -110,12 +189,34 @@ func (p *TPoolClient) call(connVar interface{}, method string, args, result thri
 		return
 	}
 
-	if err = p.Recv(inputProtocol, seqId, method, result); err != nil {
+	if err = p.Recv(ctx, inputProtocol, seqId, method, result); err != nil {
 		return err
 	}
 	return
 }
 
+// closeOnDone closes conn when ctx is done before stop is called, stop
+// reports whether it did. It returns nil for a ctx never done.
+func closeOnDone(ctx context.Context, conn net.Conn) (stop func() bool) {
+	if ctx.Done() == nil {
+		return nil
+	}
+	stopc, closed := make(chan struct{}), make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+			closed <- true
+		case <-stopc:
+			closed <- false
+		}
+	}()
+	return func() bool {
+		close(stopc)
+		return <-closed
+	}
+}
+
 func (p *TPoolClient) Destroy() {
 	p.pool.Destroy()
 }
@@ -3,8 +3,9 @@ package pool
 import (
 	"context"
 	"fmt"
-	"git.apache.org/thrift.git/lib/go/thrift"
 	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/thrift2"
+	"github.com/apache/thrift/lib/go/thrift"
 	"log"
 )
 
@@ -31,3 +32,20 @@ func ExampleNewTPoolClient() {
 	// Output:
 	// true
 }
+
+func ExampleNewTPoolClient_thrift2() {
+	protocolFactory := thrift.NewTBinaryProtocolFactoryDefault()
+	poolClient, err := NewTPoolClient(host, port, protocolFactory, protocolFactory, initialCap, maxCap)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer poolClient.Destroy()
+	client := thrift2.NewClient(poolClient)
+	result, err := client.Get(context.Background(), []byte("table"), &thrift2.TGet{Row: []byte("row")})
+	if err != nil {
+		log.Fatalln(err)
+	}
+	for _, cv := range result.ColumnValues {
+		fmt.Printf("%s:%s @%d = %s\n", cv.Family, cv.Qualifier, cv.GetTimestamp(), cv.Value)
+	}
+}
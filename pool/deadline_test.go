@@ -0,0 +1,79 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"testing"
+	"time"
+)
+
+// blockingHandler blocks GetTableNames until release is closed.
+type blockingHandler struct {
+	hbase.Hbase
+	release chan struct{}
+}
+
+func (h blockingHandler) GetTableNames(ctx context.Context) ([][]byte, error) {
+	<-h.release
+	return h.Hbase.GetTableNames(ctx)
+}
+
+func TestTPoolClient_Context(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := blockingHandler{Hbase: memhbase.New(), release: make(chan struct{})}
+	l := memhbase.Listen(hbase.NewProcessor(h), ln, nil)
+	defer l.Close()
+	defer close(h.release)
+	host, port, _ := net.SplitHostPort(l.Addr)
+
+	f := thrift.NewTBinaryProtocolFactoryConf(nil)
+	p, err := NewTPoolClient(host, port, f, f, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Destroy()
+	p.SetTimeout(time.Minute)
+	c := hbase.NewClient(p)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := c.GetTableNames(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("wanted DeadlineExceeded, got %v", err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatalf("call outlived its deadline by %v", d)
+	}
+	if n := p.pool.Len(); n != 0 {
+		t.Fatalf("%d connections returned to the pool", n)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	if _, err := c.GetTableNames(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("wanted Canceled, got %v", err)
+	}
+	if n := p.pool.Len(); n != 0 {
+		t.Fatalf("%d connections returned to the pool", n)
+	}
+	if _, err := c.GetTableNames(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("wanted Canceled for a done context, got %v", err)
+	}
+
+	// calls with time left succeed
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{{Name: []byte("f:")}}); err != nil {
+		t.Fatal(err)
+	}
+	if n := p.pool.Len(); n != 1 {
+		t.Fatalf("%d connections in the pool, want 1", n)
+	}
+}
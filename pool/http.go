@@ -0,0 +1,63 @@
+package pool
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// THttpPoolClient is a thrift.TClient for a Thrift gateway in HTTP mode. It
+// pools THttpClient transports, each serving one call at a time, which share
+// one http.Client and so its keep-alive connections.
+type THttpPoolClient struct {
+	iprotFactory, oprotFactory thrift.TProtocolFactory
+	pool                       Pool
+}
+
+// NewTHttpPoolClient returns a client calling the gateway at url, e.g.
+// "http://localhost:9090/". options may be nil.
+func NewTHttpPoolClient(url string, inputProtocol, outputProtocol thrift.TProtocolFactory, options *hbase.HTTPOptions, initialCap, maxCap int) (*THttpPoolClient, error) {
+	var o hbase.HTTPOptions
+	if options != nil {
+		o = *options
+	}
+	o.Client = options.HTTPClient()
+
+	newFunc := func() (interface{}, error) { return hbase.NewHTTPTransport(url, &o) }
+	closeFunc := func(v interface{}) error { return v.(*thrift.THttpClient).Close() }
+	p, err := NewChannelPool(initialCap, maxCap, newFunc, closeFunc, nil, DefaultIdleTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &THttpPoolClient{
+		iprotFactory: inputProtocol,
+		oprotFactory: outputProtocol,
+		pool:         p,
+	}, nil
+}
+
+func (p *THttpPoolClient) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	v, err := p.pool.Get()
+	if err == ErrTimeOut {
+		// the idle transport was dropped, the http.Client keeps the connection
+		v, err = p.pool.New()
+	}
+	if err != nil {
+		return thrift.ResponseMeta{}, err
+	}
+	trans := v.(*thrift.THttpClient)
+
+	c := thrift.NewTStandardClient(p.iprotFactory.GetProtocol(trans), p.oprotFactory.GetProtocol(trans))
+	meta, err := c.Call(ctx, method, args, result)
+	switch err.(type) {
+	case thrift.TTransportException, thrift.TProtocolException:
+		p.pool.Close(trans)
+	default:
+		p.pool.Put(trans)
+	}
+	return meta, err
+}
+
+func (p *THttpPoolClient) Destroy() {
+	p.pool.Destroy()
+}
@@ -0,0 +1,72 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTHttpPoolClient(t *testing.T) {
+	ctx := context.Background()
+	f := thrift.NewTBinaryProtocolFactoryConf(nil)
+	s := httptest.NewUnstartedServer(http.HandlerFunc(thrift.NewThriftHandlerFunc(hbase.NewProcessor(memhbase.New()), f, f)))
+	var conns int32
+	s.Config.ConnState = func(c net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	s.Start()
+	defer s.Close()
+
+	const workers = 4
+	p, err := NewTHttpPoolClient(s.URL, f, f, &hbase.HTTPOptions{MaxIdleConns: workers}, 1, workers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Destroy()
+	c := hbase.NewClient(p)
+
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = []byte("f:")
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				if _, err := c.AtomicIncrement(ctx, []byte("t"), []byte("r"), []byte("f:n"), 1); err != nil {
+					errs <- err
+					return
+				}
+			}
+			errs <- nil
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	cells, err := c.Get(ctx, []byte("t"), []byte("r"), []byte("f:n"), nil)
+	if err != nil || len(cells) != 1 || fmt.Sprint(cells[0].Value) != fmt.Sprint([]byte{0, 0, 0, 0, 0, 0, 0, 80}) {
+		t.Fatalf("Get = %v, %v", cells, err)
+	}
+	if n := atomic.LoadInt32(&conns); n > workers {
+		t.Fatalf("%d connections for %d concurrent callers, keep-alive not reused", n, workers)
+	}
+}
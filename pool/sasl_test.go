@@ -0,0 +1,79 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/He11oLx/hbase/sasl"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"sync"
+	"testing"
+)
+
+func TestSASLPoolClient(t *testing.T) {
+	ctx := context.Background()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	password := func(username string) (string, error) { return "secret", nil }
+	l := memhbase.Listen(hbase.NewProcessor(memhbase.New()), ln, func(conn net.Conn) (thrift.TTransport, error) {
+		c, err := sasl.Server(conn, map[string]func() sasl.ServerMechanism{
+			"DIGEST-MD5": func() sasl.ServerMechanism { return sasl.DigestMD5Server("", "hbase", password, sasl.AuthConf) },
+		})
+		if err != nil {
+			return nil, err
+		}
+		return thrift.NewTBufferedTransport(thrift.NewTSocketFromConnConf(c, nil), 4096), nil
+	})
+	defer l.Close()
+	host, port, _ := net.SplitHostPort(l.Addr)
+
+	const workers = 4
+	f := thrift.NewTBinaryProtocolFactoryConf(nil)
+	p, err := NewSASLPoolClient(host, port, f, f, func() sasl.Mechanism {
+		return sasl.DigestMD5("alice", "secret", "hbase", host)
+	}, 1, workers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Destroy()
+	c := hbase.NewClient(p)
+
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = []byte("f:")
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if _, err := c.AtomicIncrement(ctx, []byte("t"), []byte("r"), []byte("f:n"), 1); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	cells, err := c.Get(ctx, []byte("t"), []byte("r"), []byte("f:n"), nil)
+	if err != nil || len(cells) != 1 || fmt.Sprint(cells[0].Value) != fmt.Sprint([]byte{0, 0, 0, 0, 0, 0, 0, 80}) {
+		t.Fatalf("Get = %v, %v", cells, err)
+	}
+
+	if _, err := NewSASLPoolClient(host, port, f, f, func() sasl.Mechanism {
+		return sasl.DigestMD5("alice", "secret", "hbase", host, sasl.Auth)
+	}, 1, workers); err == nil {
+		t.Fatal("wanted an error without a common quality of protection")
+	}
+}
@@ -0,0 +1,79 @@
+package pool
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/He11oLx/hbase/internal/testcert"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"sync"
+	"testing"
+)
+
+func TestTLSPoolClient(t *testing.T) {
+	ctx := context.Background()
+	certs, err := testcert.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := certs.ServerConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := memhbase.Listen(hbase.NewProcessor(memhbase.New()), ln, nil)
+	defer l.Close()
+	host, port, _ := net.SplitHostPort(l.Addr)
+
+	const workers = 4
+	f := thrift.NewTBinaryProtocolFactoryConf(nil)
+	p, err := NewTLSPoolClient(host, port, f, f, &hbase.TLSOptions{
+		CAFile:   certs.CAFile,
+		CertFile: certs.ClientCertFile,
+		KeyFile:  certs.ClientKeyFile,
+	}, 1, workers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Destroy()
+	c := hbase.NewClient(p)
+
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = []byte("f:")
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if _, err := c.AtomicIncrement(ctx, []byte("t"), []byte("r"), []byte("f:n"), 1); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	cells, err := c.Get(ctx, []byte("t"), []byte("r"), []byte("f:n"), nil)
+	if err != nil || len(cells) != 1 || fmt.Sprint(cells[0].Value) != fmt.Sprint([]byte{0, 0, 0, 0, 0, 0, 0, 80}) {
+		t.Fatalf("Get = %v, %v", cells, err)
+	}
+
+	if _, err := NewTLSPoolClient(host, port, f, f, &hbase.TLSOptions{CAFile: certs.ServerKeyFile}, 1, workers); err == nil {
+		t.Fatal("wanted an error for a CA file without certificates")
+	}
+}
@@ -0,0 +1,38 @@
+package hbase
+
+// PrefixStopRow returns the least row greater than every row starting with
+// prefix, the exclusive StopRow of a scan of the prefix. It drops the
+// trailing 0xFF bytes and increments the last byte left, and returns nil,
+// an open end, for a prefix of 0xFF bytes only.
+func PrefixStopRow(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xFF {
+			stop := append([]byte(nil), prefix[:i+1]...)
+			stop[i]++
+			return stop
+		}
+	}
+	return nil
+}
+
+// ScanPrefix returns a Scanner over the rows starting with prefix. Unlike
+// ScannerOpenWithPrefix, which filters on the server, it bounds the scan by
+// StartRow and StopRow and keeps the other options of scan, such as Caching,
+// BatchSize, Timestamp or FilterString. A scan with Reversed set runs with
+// ScanReverse. scan may be nil and is not modified.
+func ScanPrefix(c Hbase, tableName, prefix []byte, scan *TScan, attributes map[string][]byte) *Scanner {
+	if scan != nil && scan.Reversed != nil && *scan.Reversed {
+		return ScanReverse(c, tableName, prefix, PrefixStopRow(prefix), scan, attributes)
+	}
+	s := NewTScan()
+	if scan != nil {
+		*s = *scan
+	}
+	s.StartRow, s.StopRow = prefix, PrefixStopRow(prefix)
+	return NewScanner(c, tableName, s, attributes)
+}
+
+// ScanPrefix runs ScanPrefix on the client.
+func (p *Client) ScanPrefix(tableName, prefix []byte, scan *TScan, attributes map[string][]byte) *Scanner {
+	return ScanPrefix(p, tableName, prefix, scan, attributes)
+}
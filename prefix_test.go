@@ -0,0 +1,75 @@
+package hbase_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestPrefixStopRow(t *testing.T) {
+	for prefix, want := range map[string]string{
+		"":          "",
+		"a":         "b",
+		"ab":        "ac",
+		"a\xff":     "b",
+		"a\xff\xff": "b",
+		"\x00":      "\x01",
+		"a\xfe":     "a\xff",
+		"\xff":      "",
+		"\xff\xff":  "",
+		"\xffa\xff": "\xffb",
+	} {
+		if got := hbase.PrefixStopRow([]byte(prefix)); !bytes.Equal(got, []byte(want)) {
+			t.Errorf("PrefixStopRow(%q) = %q, wanted %q", prefix, got, want)
+		}
+	}
+	// the prefix is not modified
+	p := []byte("ab")
+	hbase.PrefixStopRow(p)
+	if string(p) != "ab" {
+		t.Fatalf("prefix modified to %q", p)
+	}
+}
+
+func TestScanPrefix(t *testing.T) {
+	ctx := context.Background()
+	e := newReverseServer(t, 2)
+	for _, prefix := range []string{"", "a", "a\x00", "ab", "b", "\xff", "z"} {
+		for _, reversed := range []bool{false, true} {
+			var want []string
+			for _, k := range reverseKeys {
+				if strings.HasPrefix(k, prefix) {
+					want = append(want, k)
+				}
+			}
+			if reversed {
+				for i, j := 0, len(want)-1; i < j; i, j = i+1, j-1 {
+					want[i], want[j] = want[j], want[i]
+				}
+			}
+			scan := &hbase.TScan{Caching: thrift.Int32Ptr(2), Reversed: &reversed, Columns: [][]byte{[]byte("f:a")}}
+			s := hbase.ScanPrefix(e, []byte("t"), []byte(prefix), scan, nil)
+			var got []string
+			for {
+				r, err := s.Next(ctx)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, string(r.Row))
+			}
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("ScanPrefix(%q) reversed %v = %q, wanted %q", prefix, reversed, got, want)
+			}
+			if scan.StartRow != nil || scan.StopRow != nil {
+				t.Fatal("scan modified")
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package hbase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRegionRefresh is how often a RegionCache reloads the regions of the
+// tables it holds.
+var DefaultRegionRefresh = 5 * time.Minute
+
+var ErrNoRegion = errors.New("hbase: no region holds the row")
+
+// regionErrors are the exceptions a region server raises for a region it
+// does not serve, found in the IOError messages of the gateway.
+var regionErrors = []string{
+	"NotServingRegionException",
+	"RegionMovedException",
+	"RegionOpeningException",
+	"WrongRegionException",
+}
+
+// IsRegionError reports whether err is an IOError for a region which moved,
+// was split or is not online, after which cached region boundaries are
+// stale.
+func IsRegionError(err error) bool {
+	var e *IOError
+	if !errors.As(err, &e) {
+		return false
+	}
+	for _, s := range regionErrors {
+		if strings.Contains(e.Message, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// RegionCache caches the regions of tables from GetTableRegions, sorted by
+// StartKey, and reloads them every refresh until Close.
+type RegionCache struct {
+	c    Hbase
+	stop chan struct{}
+	once sync.Once
+
+	mu     sync.Mutex
+	tables map[string][]*TRegionInfo
+}
+
+// NewRegionCache returns a cache of the regions of c, reloaded every refresh,
+// never if refresh is not positive.
+func NewRegionCache(c Hbase, refresh time.Duration) *RegionCache {
+	rc := &RegionCache{c: c, stop: make(chan struct{}), tables: make(map[string][]*TRegionInfo)}
+	if refresh > 0 {
+		go rc.refresh(refresh)
+	}
+	return rc
+}
+
+func (rc *RegionCache) refresh(every time.Duration) {
+	t := time.NewTicker(every)
+	defer t.Stop()
+	for {
+		select {
+		case <-rc.stop:
+			return
+		case <-t.C:
+		}
+		rc.mu.Lock()
+		tables := make([]string, 0, len(rc.tables))
+		for table := range rc.tables {
+			tables = append(tables, table)
+		}
+		rc.mu.Unlock()
+		for _, table := range tables {
+			// a table failing to load is dropped, and loaded again when used
+			if _, err := rc.load(context.Background(), []byte(table)); err != nil {
+				rc.Invalidate([]byte(table))
+			}
+		}
+	}
+}
+
+// Close stops the reloads.
+func (rc *RegionCache) Close() {
+	rc.once.Do(func() { close(rc.stop) })
+}
+
+// Regions returns the regions of the table sorted by StartKey, loading them
+// if they are not cached. The result must not be modified.
+func (rc *RegionCache) Regions(ctx context.Context, tableName []byte) ([]*TRegionInfo, error) {
+	rc.mu.Lock()
+	regions, ok := rc.tables[string(tableName)]
+	rc.mu.Unlock()
+	if ok {
+		return regions, nil
+	}
+	return rc.load(ctx, tableName)
+}
+
+func (rc *RegionCache) load(ctx context.Context, tableName []byte) ([]*TRegionInfo, error) {
+	regions, err := rc.c.GetTableRegions(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(regions, func(i, j int) bool { return bytes.Compare(regions[i].StartKey, regions[j].StartKey) < 0 })
+	rc.mu.Lock()
+	rc.tables[string(tableName)] = regions
+	rc.mu.Unlock()
+	return regions, nil
+}
+
+// RegionFor returns the region holding row, the last one starting at or
+// before it. It returns ErrNoRegion if none does.
+func (rc *RegionCache) RegionFor(ctx context.Context, tableName, row []byte) (*TRegionInfo, error) {
+	regions, err := rc.Regions(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	if r := regionFor(regions, row); r != nil {
+		return r, nil
+	}
+	return nil, ErrNoRegion
+}
+
+// regionFor finds the region of row in regions sorted by StartKey.
+func regionFor(regions []*TRegionInfo, row []byte) *TRegionInfo {
+	i := sort.Search(len(regions), func(i int) bool { return bytes.Compare(regions[i].StartKey, row) > 0 })
+	if i == 0 {
+		return nil
+	}
+	r := regions[i-1]
+	if len(r.EndKey) > 0 && bytes.Compare(row, r.EndKey) >= 0 {
+		return nil
+	}
+	return r
+}
+
+// Invalidate drops the regions of the table, they are loaded again when
+// used.
+func (rc *RegionCache) Invalidate(tableName []byte) {
+	rc.mu.Lock()
+	delete(rc.tables, string(tableName))
+	rc.mu.Unlock()
+}
+
+// Check invalidates the regions of the table if err is a region error, and
+// returns err.
+func (rc *RegionCache) Check(tableName []byte, err error) error {
+	if IsRegionError(err) {
+		rc.Invalidate(tableName)
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+package hbase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/hbasemock"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// regionCounter counts the GetTableRegions calls.
+type regionCounter struct {
+	*memhbase.Server
+	loads int32
+}
+
+func (r *regionCounter) GetTableRegions(ctx context.Context, tableName []byte) ([]*hbase.TRegionInfo, error) {
+	atomic.AddInt32(&r.loads, 1)
+	return r.Server.GetTableRegions(ctx, tableName)
+}
+
+func TestRegionCache(t *testing.T) {
+	ctx := context.Background()
+	s := &regionCounter{Server: newExpiring(t, 0).Server}
+	if err := s.Split([]byte("t"), []byte("m"), []byte("g")); err != nil {
+		t.Fatal(err)
+	}
+	rc := hbase.NewRegionCache(s, 0)
+	defer rc.Close()
+
+	for row, start := range map[string]string{"": "", "a": "", "g": "g", "g\x00": "g", "l\xff": "g", "m": "m", "zzz": "m"} {
+		r, err := rc.RegionFor(ctx, []byte("t"), []byte(row))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(r.StartKey) != start {
+			t.Errorf("RegionFor(%q) starts at %q, wanted %q", row, r.StartKey, start)
+		}
+	}
+	if n := atomic.LoadInt32(&s.loads); n != 1 {
+		t.Fatalf("%d loads", n)
+	}
+
+	s.Split([]byte("t"), []byte("m"), []byte("g"), []byte("p"))
+	if r, _ := rc.RegionFor(ctx, []byte("t"), []byte("q")); string(r.StartKey) != "m" {
+		t.Fatalf("cached RegionFor(q) starts at %q", r.StartKey)
+	}
+	moved := &hbase.IOError{Message: "org.apache.hadoop.hbase.NotServingRegionException: Region t,m,3 is not online on rs1"}
+	if err := rc.Check([]byte("t"), fmt.Errorf("put: %w", moved)); !errors.Is(err, moved) {
+		t.Fatalf("Check returned %v", err)
+	}
+	if r, _ := rc.RegionFor(ctx, []byte("t"), []byte("q")); string(r.StartKey) != "p" {
+		t.Fatalf("RegionFor(q) after a region error starts at %q", r.StartKey)
+	}
+	rc.Check([]byte("t"), &hbase.IOError{Message: "org.apache.hadoop.hbase.RegionTooBusyException"})
+	rc.RegionFor(ctx, []byte("t"), []byte("q"))
+	if n := atomic.LoadInt32(&s.loads); n != 2 {
+		t.Fatalf("%d loads, only region errors invalidate", n)
+	}
+
+	if _, err := rc.RegionFor(ctx, []byte("missing"), []byte("a")); err == nil {
+		t.Fatal("wanted an error for a missing table")
+	}
+}
+
+func TestRegionCache_Refresh(t *testing.T) {
+	ctx := context.Background()
+	s := &regionCounter{Server: newExpiring(t, 0).Server}
+	rc := hbase.NewRegionCache(s, 10*time.Millisecond)
+	defer rc.Close()
+	if r, err := rc.RegionFor(ctx, []byte("t"), []byte("x")); err != nil || len(r.StartKey) != 0 {
+		t.Fatalf("RegionFor = %v, %v", r, err)
+	}
+	s.Split([]byte("t"), []byte("k"))
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		r, err := rc.RegionFor(ctx, []byte("t"), []byte("x"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(r.StartKey) == "k" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("regions not refreshed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRegionCache_NoRegion(t *testing.T) {
+	m := hbasemock.New(t)
+	m.On("GetTableRegions", "t").Return([]*hbase.TRegionInfo{
+		{StartKey: []byte("b"), EndKey: []byte("d")},
+		{StartKey: []byte("f"), EndKey: []byte{}},
+	}, nil)
+	rc := hbase.NewRegionCache(m, 0)
+	for _, row := range []string{"a", "d", "e"} {
+		if _, err := rc.RegionFor(context.Background(), []byte("t"), []byte(row)); err != hbase.ErrNoRegion {
+			t.Errorf("RegionFor(%q) = %v, wanted ErrNoRegion", row, err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package hbase
+
+import (
+	"bytes"
+	"context"
+	"sync"
+)
+
+// DefaultRegionParallelism is the number of regions CountRows and Aggregate
+// scan at once.
+var DefaultRegionParallelism = 8
+
+// regionScans splits scan, which is not modified, into one forward scan per
+// region of the table it overlaps.
+func regionScans(ctx context.Context, c Hbase, tableName []byte, scan *TScan) ([]*TScan, error) {
+	regions, err := c.GetTableRegions(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	from, to := scan.StartRow, scan.StopRow
+	if scan.Reversed != nil && *scan.Reversed {
+		// the rows of a reversed scan are above StopRow and up to StartRow,
+		// the forward scan from and to the keys following them
+		from, to = nextKey(scan.StopRow), nextKey(scan.StartRow)
+	}
+	var scans []*TScan
+	for _, r := range regions {
+		start, stop := from, to
+		if len(r.StartKey) > 0 && bytes.Compare(r.StartKey, start) > 0 {
+			start = r.StartKey
+		}
+		if len(r.EndKey) > 0 && (len(stop) == 0 || bytes.Compare(r.EndKey, stop) < 0) {
+			stop = r.EndKey
+		}
+		if len(stop) > 0 && bytes.Compare(start, stop) >= 0 {
+			continue
+		}
+		s := *scan
+		s.StartRow, s.StopRow, s.Reversed = start, stop, nil
+		scans = append(scans, &s)
+	}
+	return scans, nil
+}
+
+// nextKey returns the least key after key, key with a zero byte appended,
+// and an empty key, an open end, for an empty one.
+func nextKey(key []byte) []byte {
+	if len(key) == 0 {
+		return key
+	}
+	return append(append(make([]byte, 0, len(key)+1), key...), 0)
+}
+
+// eachScan runs fn for every scan, DefaultRegionParallelism at once, and
+// returns the first error, which cancels the context of the others.
+func eachScan(ctx context.Context, scans []*TScan, fn func(ctx context.Context, i int, scan *TScan) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	n := DefaultRegionParallelism
+	if n <= 0 {
+		n = 1
+	}
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+		sem   = make(chan struct{}, n)
+	)
+	for i, s := range scans {
+		sem <- struct{}{}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int, s *TScan) {
+			defer func() { <-sem; wg.Done() }()
+			if err := fn(ctx, i, s); err != nil {
+				once.Do(func() { first = err; cancel() })
+			}
+		}(i, s)
+	}
+	wg.Wait()
+	if first == nil {
+		// canceled by the caller
+		first = ctx.Err()
+	}
+	return first
+}
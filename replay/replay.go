@@ -0,0 +1,241 @@
+// Package replay records the calls of a thrift.TClient to a golden file and
+// serves them back without a server, so tests of code using a Client run
+// offline against interactions captured once from a real gateway.
+//
+// A golden file holds one JSON object per call, with the arguments and the
+// result in the Thrift JSON protocol.
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrUnexpectedCall is returned by a strict Replayer for calls not recorded.
+var ErrUnexpectedCall = errors.New("replay: unexpected call")
+
+// Call is a recorded call.
+type Call struct {
+	Method string          `json:"method"`
+	Args   json.RawMessage `json:"args"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *Error          `json:"error,omitempty"`
+}
+
+// Error is an error returned by a recorded call, Kind is "transport",
+// "protocol", "application" or "other".
+type Error struct {
+	Kind    string `json:"kind"`
+	Type    int32  `json:"type,omitempty"`
+	Message string `json:"message"`
+}
+
+func newError(err error) *Error {
+	var te thrift.TException
+	if errors.As(err, &te) {
+		switch te.TExceptionType() {
+		case thrift.TExceptionTypeTransport:
+			if e, ok := te.(thrift.TTransportException); ok {
+				return &Error{Kind: "transport", Type: int32(e.TypeId()), Message: err.Error()}
+			}
+		case thrift.TExceptionTypeProtocol:
+			if e, ok := te.(thrift.TProtocolException); ok {
+				return &Error{Kind: "protocol", Type: int32(e.TypeId()), Message: err.Error()}
+			}
+		case thrift.TExceptionTypeApplication:
+			if e, ok := te.(thrift.TApplicationException); ok {
+				return &Error{Kind: "application", Type: e.TypeId(), Message: err.Error()}
+			}
+		}
+	}
+	return &Error{Kind: "other", Message: err.Error()}
+}
+
+func (e *Error) err() error {
+	switch e.Kind {
+	case "transport":
+		return thrift.NewTTransportException(int(e.Type), e.Message)
+	case "protocol":
+		return thrift.NewTProtocolExceptionWithType(int(e.Type), errors.New(e.Message))
+	case "application":
+		return thrift.NewTApplicationException(e.Type, e.Message)
+	}
+	return errors.New(e.Message)
+}
+
+func encode(ctx context.Context, s thrift.TStruct) (json.RawMessage, error) {
+	buf := thrift.NewTMemoryBuffer()
+	p := thrift.NewTJSONProtocol(buf)
+	if err := s.Write(ctx, p); err != nil {
+		return nil, err
+	}
+	if err := p.Flush(ctx); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func decode(ctx context.Context, b json.RawMessage, s thrift.TStruct) error {
+	buf := thrift.NewTMemoryBuffer()
+	buf.Write(b)
+	return s.Read(ctx, thrift.NewTJSONProtocol(buf))
+}
+
+// Recorder is a thrift.TClient recording the calls of another one.
+type Recorder struct {
+	c thrift.TClient
+
+	mu  sync.Mutex
+	enc *json.Encoder
+	err error
+}
+
+// NewRecorder returns a Recorder writing the calls of c to w.
+func NewRecorder(c thrift.TClient, w io.Writer) *Recorder {
+	return &Recorder{c: c, enc: json.NewEncoder(w)}
+}
+
+func (r *Recorder) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	call := Call{Method: method}
+	// encoded first, args are not modified by a call but result is
+	a, encErr := encode(ctx, args)
+	call.Args = a
+	meta, err := r.c.Call(ctx, method, args, result)
+	if err != nil {
+		call.Error = newError(err)
+	} else if result != nil && encErr == nil {
+		call.Result, encErr = encode(ctx, result)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if encErr == nil {
+		encErr = r.enc.Encode(&call)
+	}
+	if r.err == nil && encErr != nil {
+		r.err = fmt.Errorf("replay: recording %s: %v", method, encErr)
+	}
+	return meta, err
+}
+
+// Err returns the first error recording a call.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+type entry struct {
+	Call
+	args     interface{}
+	replayed bool
+}
+
+// Replayer is a thrift.TClient serving recorded calls. A call is served by
+// the first call recorded with the same method and arguments not served yet,
+// so calls made concurrently may come in any order.
+//
+// A strict Replayer fails calls not recorded with ErrUnexpectedCall, and
+// Verify reports the recorded calls not served. Otherwise they succeed with
+// an empty result, i.e. nothing found.
+type Replayer struct {
+	strict bool
+
+	mu         sync.Mutex
+	calls      []*entry
+	unexpected []string
+}
+
+// NewReplayer reads the calls written by a Recorder from r.
+func NewReplayer(r io.Reader, strict bool) (*Replayer, error) {
+	rp := &Replayer{strict: strict}
+	dec := json.NewDecoder(r)
+	for {
+		e := &entry{}
+		if err := dec.Decode(&e.Call); err == io.EOF {
+			return rp, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("replay: call %d: %v", len(rp.calls)+1, err)
+		}
+		if err := json.Unmarshal(e.Args, &e.args); err != nil {
+			return nil, fmt.Errorf("replay: arguments of call %d: %v", len(rp.calls)+1, err)
+		}
+		rp.calls = append(rp.calls, e)
+	}
+}
+
+// Open reads the golden file name.
+func Open(name string, strict bool) (*Replayer, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return NewReplayer(f, strict)
+}
+
+func (r *Replayer) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	var meta thrift.ResponseMeta
+	a, err := encode(ctx, args)
+	if err != nil {
+		return meta, err
+	}
+	// decoded, so that maps compare regardless of their order
+	var decoded interface{}
+	if err := json.Unmarshal(a, &decoded); err != nil {
+		return meta, err
+	}
+
+	r.mu.Lock()
+	var match *entry
+	for _, e := range r.calls {
+		if !e.replayed && e.Method == method && reflect.DeepEqual(e.args, decoded) {
+			match, e.replayed = e, true
+			break
+		}
+	}
+	if match == nil && r.strict {
+		r.unexpected = append(r.unexpected, method+" "+string(a))
+	}
+	r.mu.Unlock()
+
+	switch {
+	case match == nil && r.strict:
+		return meta, fmt.Errorf("%w: %s %s", ErrUnexpectedCall, method, a)
+	case match == nil:
+		return meta, nil
+	case match.Error != nil:
+		return meta, match.Error.err()
+	case result != nil && match.Result != nil:
+		return meta, decode(ctx, match.Result, result)
+	}
+	return meta, nil
+}
+
+// Verify reports, for a strict Replayer, the calls not recorded and the
+// recorded calls not served.
+func (r *Replayer) Verify() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var problems []string
+	for _, u := range r.unexpected {
+		problems = append(problems, "unexpected call "+u)
+	}
+	for _, e := range r.calls {
+		if r.strict && !e.replayed {
+			problems = append(problems, "call not replayed "+e.Method+" "+string(e.Args))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New("replay: " + strings.Join(problems, "\n\t"))
+}
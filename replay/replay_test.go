@@ -0,0 +1,105 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"strings"
+	"testing"
+)
+
+// failing fails every call with a transport error.
+type failing struct{}
+
+func (failing) Call(context.Context, string, thrift.TStruct, thrift.TStruct) (thrift.ResponseMeta, error) {
+	return thrift.ResponseMeta{}, thrift.NewTTransportException(thrift.TIMED_OUT, "i/o timeout")
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	var golden bytes.Buffer
+	rec := NewRecorder(memhbase.NewLoopback(memhbase.New()), &golden)
+	c := hbase.NewClient(rec)
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = []byte("f:")
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	attributes := map[string][]byte{"a": []byte("1"), "b": []byte("2"), "c": []byte("3")}
+	m := hbase.NewMutation()
+	m.Column, m.Value = []byte("f:a"), []byte("v")
+	if err := c.MutateRow(ctx, []byte("t"), []byte("r"), []*hbase.Mutation{m}, attributes); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), attributes); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(ctx, []byte("missing"), []byte("r"), []byte("f:a"), nil); err == nil {
+		t.Fatal("wanted IOError")
+	}
+	if _, err := hbase.NewClient(NewRecorder(failing{}, &golden)).GetTableNames(ctx); err == nil {
+		t.Fatal("wanted an error")
+	}
+	if err := rec.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(golden.String(), "\n"); n != 5 {
+		t.Fatalf("%d calls recorded:\n%s", n, golden.String())
+	}
+
+	r, err := NewReplayer(bytes.NewReader(golden.Bytes()), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = hbase.NewClient(r)
+	// replayed in another order, with the attributes in another map
+	if _, err := c.GetTableNames(ctx); err == nil {
+		t.Fatal("wanted the recorded error")
+	} else if e, ok := err.(thrift.TTransportException); !ok || e.TypeId() != thrift.TIMED_OUT {
+		t.Fatalf("wanted a TTransportException TIMED_OUT, got %T %v", err, err)
+	}
+	if _, err := c.Get(ctx, []byte("missing"), []byte("r"), []byte("f:a"), nil); err == nil {
+		t.Fatal("wanted IOError")
+	} else if _, ok := err.(*hbase.IOError); !ok {
+		t.Fatalf("wanted IOError, got %T %v", err, err)
+	}
+	cells, err := c.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), map[string][]byte{"c": []byte("3"), "b": []byte("2"), "a": []byte("1")})
+	if err != nil || len(cells) != 1 || string(cells[0].Value) != "v" {
+		t.Fatalf("Get = %v, %v", cells, err)
+	}
+	if err := r.Verify(); err == nil || !strings.Contains(err.Error(), "call not replayed createTable") {
+		t.Fatalf("Verify = %v", err)
+	}
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.MutateRow(ctx, []byte("t"), []byte("r"), []*hbase.Mutation{m}, attributes); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	// served once
+	if _, err := c.Get(ctx, []byte("t"), []byte("r"), []byte("f:a"), attributes); !errors.Is(err, ErrUnexpectedCall) {
+		t.Fatalf("wanted ErrUnexpectedCall, got %v", err)
+	}
+	if err := r.Verify(); err == nil || !strings.Contains(err.Error(), "unexpected call get") {
+		t.Fatalf("Verify = %v", err)
+	}
+
+	r, err = NewReplayer(bytes.NewReader(golden.Bytes()), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = hbase.NewClient(r)
+	if cells, err := c.Get(ctx, []byte("t"), []byte("other"), []byte("f:a"), nil); err != nil || len(cells) != 0 {
+		t.Fatalf("unexpected Get = %v, %v", cells, err)
+	}
+	if err := r.Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,68 @@
+package hbase
+
+import (
+	"context"
+	"github.com/apache/thrift/lib/go/thrift"
+	"io"
+)
+
+// ScanReverse returns a Scanner over the rows from startRow inclusive to
+// stopRow exclusive, the rows of the forward scan with these bounds, in
+// descending order. An empty row leaves that end open. The other options
+// come from scan, which may be nil and is not modified.
+//
+// A reversed TScan takes its StartRow as the inclusive upper bound and its
+// StopRow as the exclusive lower bound. ScanReverse starts it at stopRow and
+// drops that row, and ends the scan at the first row below startRow. The
+// greatest row below startRow has no finite key unless startRow ends with a
+// zero byte, so the gateway stops below startRow with its last byte
+// decremented: the rows starting with that key may be read, at most a page
+// more, and dropped.
+func ScanReverse(c Hbase, tableName, startRow, stopRow []byte, scan *TScan, attributes map[string][]byte) *Scanner {
+	reversed := NewTScan()
+	if scan != nil {
+		*reversed = *scan
+	}
+	reversed.Reversed = thrift.BoolPtr(true)
+	reversed.StartRow, reversed.StopRow = stopRow, nil
+	if n := len(startRow); n > 0 {
+		// with a last zero byte, startRow without it is the greatest row below
+		reversed.StopRow = startRow[:n-1]
+		if last := startRow[n-1]; last > 0 {
+			reversed.StopRow = append(append(make([]byte, 0, n), startRow[:n-1]...), last-1)
+		}
+	}
+	s := NewScanner(c, tableName, reversed, attributes)
+	if len(stopRow) > 0 {
+		s.skip = stopRow
+	}
+	if len(startRow) > 0 {
+		s.lower = startRow
+	}
+	return s
+}
+
+// ScanReverse runs ScanReverse on the client.
+func (p *Client) ScanReverse(tableName, startRow, stopRow []byte, scan *TScan, attributes map[string][]byte) *Scanner {
+	return ScanReverse(p, tableName, startRow, stopRow, scan, attributes)
+}
+
+// LastRowWithPrefix returns the greatest row starting with prefix, with the
+// given columns or all of them, or nil if there is none.
+func LastRowWithPrefix(ctx context.Context, c Hbase, tableName, prefix []byte, columns [][]byte, attributes map[string][]byte) (*TRowResult_, error) {
+	scan := NewTScan()
+	scan.Columns = columns
+	scan.Caching = thrift.Int32Ptr(1)
+	s := ScanReverse(c, tableName, prefix, PrefixStopRow(prefix), scan, attributes)
+	defer s.Close(ctx)
+	r, err := s.Next(ctx)
+	if err == io.EOF {
+		return nil, nil
+	}
+	return r, err
+}
+
+// LastRowWithPrefix runs LastRowWithPrefix on the client.
+func (p *Client) LastRowWithPrefix(ctx context.Context, tableName, prefix []byte, columns [][]byte, attributes map[string][]byte) (*TRowResult_, error) {
+	return LastRowWithPrefix(ctx, p, tableName, prefix, columns, attributes)
+}
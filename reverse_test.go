@@ -0,0 +1,133 @@
+package hbase_test
+
+import (
+	"context"
+	"github.com/He11oLx/hbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"io"
+	"testing"
+)
+
+var reverseKeys = []string{"a", "a\x00", "ab", "ab\xff", "ac", "b", "\xff", "\xff\xff"}
+
+func newReverseServer(t *testing.T, expire ...int) *expiring {
+	e := newExpiring(t, 0, expire...)
+	for _, k := range reverseKeys {
+		m := hbase.NewMutation()
+		m.Column, m.Value = []byte("f:a"), []byte(k)
+		if err := e.MutateRow(context.Background(), []byte("t"), []byte(k), []*hbase.Mutation{m}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return e
+}
+
+func TestScanReverse(t *testing.T) {
+	ctx := context.Background()
+	bounds := append([]string{"", "0", "a\x00\x00", "aa", "ab\xff\x00", "zz"}, reverseKeys...)
+	for _, start := range bounds {
+		for _, stop := range bounds {
+			e := newReverseServer(t, 2)
+			scan := hbase.NewTScan()
+			scan.StartRow, scan.StopRow = []byte(start), []byte(stop)
+			forward, err := hbase.ScanAll(ctx, e, []byte("t"), scan, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := hbase.ScanReverse(e, []byte("t"), []byte(start), []byte(stop), &hbase.TScan{Caching: thrift.Int32Ptr(2)}, nil)
+			var got []string
+			for {
+				r, err := s.Next(ctx)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, string(r.Row))
+			}
+			s.Close(ctx)
+			if len(got) != len(forward) {
+				t.Fatalf("[%q, %q): reversed %q, forward has %d rows", start, stop, got, len(forward))
+			}
+			for i, r := range forward {
+				if got[len(got)-1-i] != string(r.Row) {
+					t.Fatalf("[%q, %q): reversed %q, forward row %d is %q", start, stop, got, i, r.Row)
+				}
+			}
+		}
+	}
+}
+
+func TestLastRowWithPrefix(t *testing.T) {
+	ctx := context.Background()
+	e := newReverseServer(t)
+	for prefix, want := range map[string]string{
+		"":      "\xff\xff",
+		"a":     "ac",
+		"a\x00": "a\x00",
+		"ab":    "ab\xff",
+		"b":     "b",
+		"\xff":  "\xff\xff",
+		"aa":    "",
+		"z":     "",
+	} {
+		r, err := hbase.LastRowWithPrefix(ctx, e, []byte("t"), []byte(prefix), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want == "" {
+			if r != nil {
+				t.Errorf("LastRowWithPrefix(%q) = %q, wanted none", prefix, r.Row)
+			}
+			continue
+		}
+		if r == nil || string(r.Row) != want || string(r.Columns["f:a"].Value) != want {
+			t.Errorf("LastRowWithPrefix(%q) = %v, wanted %q", prefix, r, want)
+		}
+	}
+}
+
+func TestScanReverse_StopRow(t *testing.T) {
+	ctx := context.Background()
+	e := newExpiring(t, 0)
+	for _, k := range []string{"a", "b", "b\x00", "ba", "c", "d"} {
+		m := hbase.NewMutation()
+		m.Column, m.Value = []byte("f:a"), []byte(k)
+		if err := e.MutateRow(ctx, []byte("t"), []byte(k), []*hbase.Mutation{m}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := &scanRecorder{Server: e.Server}
+	for _, c := range []struct {
+		start, stop string
+		rows        int
+	}{
+		// a single byte start row does not leave the lower bound open
+		{"c", "b", 2},
+		{"c\x00", "c", 1},
+		{"ba", "b`", 3},
+		{"\x00", "", 6},
+	} {
+		s.scans = nil
+		sc := hbase.ScanReverse(s, []byte("t"), []byte(c.start), nil, nil, nil)
+		rows := 0
+		for {
+			_, err := sc.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			rows++
+		}
+		if rows != c.rows {
+			t.Fatalf("ScanReverse from %q: %d rows, wanted %d", c.start, rows, c.rows)
+		}
+		if len(s.scans) != 1 || string(s.scans[0].StopRow) != c.stop {
+			t.Fatalf("ScanReverse from %q: scans %v, wanted stop row %q", c.start, s.scans, c.stop)
+		}
+	}
+}
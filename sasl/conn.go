@@ -0,0 +1,61 @@
+package sasl
+
+import (
+	"net"
+)
+
+// Conn is a net.Conn after the negotiation, framing and wrapping the data.
+// Every Write is sent as one frame or more, so writes should be buffered, e.g.
+// by thrift.TBufferedTransport.
+type Conn struct {
+	net.Conn
+	layer    Layer
+	identity string
+	rbuf     []byte
+}
+
+// Client negotiates m on conn. conn is not closed on failure.
+func Client(conn net.Conn, m Mechanism) (*Conn, error) {
+	l, err := clientHandshake(conn, noFlush, m)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{Conn: conn, layer: l}, nil
+}
+
+// Server negotiates with a client on conn, using the mechanism named by the
+// client in mechanisms. conn is not closed on failure.
+func Server(conn net.Conn, mechanisms map[string]func() ServerMechanism) (*Conn, error) {
+	m, err := serverHandshake(conn, noFlush, mechanisms)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{Conn: conn, layer: m.Layer(), identity: m.Identity()}, nil
+}
+
+func noFlush() error { return nil }
+
+// Identity is the user authenticated by Server, empty for a Client.
+func (c *Conn) Identity() string {
+	return c.identity
+}
+
+func (c *Conn) Read(p []byte) (int, error) {
+	for len(c.rbuf) == 0 {
+		frame, err := readFrame(c.Conn, c.layer)
+		if err != nil {
+			return 0, err
+		}
+		c.rbuf = frame
+	}
+	n := copy(p, c.rbuf)
+	c.rbuf = c.rbuf[n:]
+	return n, nil
+}
+
+func (c *Conn) Write(p []byte) (int, error) {
+	if err := writeFrames(c.Conn, c.layer, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,448 @@
+package sasl
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rc4"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxBuf is the largest wrapped frame accepted, announced to the peer
+// by DIGEST-MD5.
+var DefaultMaxBuf = 65536
+
+// ciphers are the DIGEST-MD5 ciphers supported in order of preference, with
+// the number of bytes of H(A1) their keys derive from.
+var ciphers = []struct {
+	name string
+	n    int
+}{{"rc4", 16}, {"rc4-56", 7}, {"rc4-40", 5}}
+
+var defaultQOP = []QOP{AuthConf, AuthInt, Auth}
+
+type digestMD5 struct {
+	username, password, service, host string
+	qop                               []QOP
+	cnonce                            string
+
+	step     int
+	complete bool
+	rspauth  string
+	layer    Layer
+}
+
+// DigestMD5 returns the DIGEST-MD5 mechanism (RFC 2831) authenticating
+// username to service on host, e.g. "hbase" and the host name of the gateway.
+// qop lists the qualities of protection accepted in order of preference,
+// AuthConf, AuthInt and Auth if empty. AuthConf needs an RC4 cipher.
+func DigestMD5(username, password, service, host string, qop ...QOP) Mechanism {
+	if len(qop) == 0 {
+		qop = defaultQOP
+	}
+	return &digestMD5{username: username, password: password, service: service, host: host, qop: qop}
+}
+
+func (m *digestMD5) Name() string { return "DIGEST-MD5" }
+
+// Start sends no initial response, the server challenges first.
+func (m *digestMD5) Start() ([]byte, error) { return nil, nil }
+
+func (m *digestMD5) Next(challenge []byte) ([]byte, error) {
+	d, err := parseDirectives(challenge)
+	if err != nil {
+		return nil, err
+	}
+	switch m.step {
+	case 0:
+		m.step++
+		return m.respond(d)
+	case 1:
+		m.step++
+		if !hmac.Equal([]byte(d["rspauth"]), []byte(m.rspauth)) {
+			return nil, errors.New("sasl: DIGEST-MD5 server failed to authenticate")
+		}
+		m.complete = true
+		return nil, nil
+	}
+	return nil, errors.New("sasl: DIGEST-MD5 is complete")
+}
+
+func (m *digestMD5) respond(d map[string]string) ([]byte, error) {
+	nonce := d["nonce"]
+	if nonce == "" {
+		return nil, errors.New("sasl: DIGEST-MD5 challenge without nonce")
+	}
+	if d["algorithm"] != "md5-sess" {
+		return nil, fmt.Errorf("sasl: DIGEST-MD5 algorithm %q unsupported", d["algorithm"])
+	}
+	offered := splitList(d["qop"], string(Auth))
+	var qop QOP
+	for _, q := range m.qop {
+		if contains(offered, string(q)) {
+			qop = q
+			break
+		}
+	}
+	if qop == "" {
+		return nil, fmt.Errorf("sasl: no quality of protection in common with the server offering %s", d["qop"])
+	}
+	cipher := ""
+	if qop == AuthConf {
+		if cipher = chooseCipher(splitList(d["cipher"], "")); cipher == "" {
+			return nil, fmt.Errorf("sasl: no cipher in common with the server offering %s", d["cipher"])
+		}
+	}
+	maxbuf, err := parseMaxBuf(d["maxbuf"])
+	if err != nil {
+		return nil, err
+	}
+	if m.cnonce == "" {
+		if m.cnonce, err = newNonce(); err != nil {
+			return nil, err
+		}
+	}
+
+	const nc = "00000001"
+	realm, uri := d["realm"], m.service+"/"+m.host
+	hA1 := digestHA1(m.username, realm, m.password, nonce, m.cnonce)
+	var b strings.Builder
+	if d["charset"] == "utf-8" {
+		b.WriteString("charset=utf-8,")
+	}
+	fmt.Fprintf(&b, "username=%s,", quote(m.username))
+	if realm != "" {
+		fmt.Fprintf(&b, "realm=%s,", quote(realm))
+	}
+	fmt.Fprintf(&b, "nonce=%s,nc=%s,cnonce=%s,digest-uri=%s,maxbuf=%d,response=%s,qop=%s",
+		quote(nonce), nc, quote(m.cnonce), quote(uri), DefaultMaxBuf,
+		digestResponse(hA1, nonce, nc, m.cnonce, qop, "AUTHENTICATE:"+uri), qop)
+	if cipher != "" {
+		fmt.Fprintf(&b, ",cipher=%s", cipher)
+	}
+	m.rspauth = digestResponse(hA1, nonce, nc, m.cnonce, qop, ":"+uri)
+	if qop != Auth {
+		m.layer = newDigestLayer(hA1, qop, cipher, maxbuf, true)
+	}
+	return []byte(b.String()), nil
+}
+
+func (m *digestMD5) Complete() bool { return m.complete }
+func (m *digestMD5) Layer() Layer   { return m.layer }
+
+type digestMD5Server struct {
+	realm, service string
+	password       func(username string) (string, error)
+	qop            []QOP
+	nonce          string
+
+	step     int
+	complete bool
+	identity string
+	layer    Layer
+}
+
+// DigestMD5Server returns the server side of DIGEST-MD5 for service in realm.
+// password looks up the password of a user, qop lists the qualities of
+// protection offered, AuthConf, AuthInt and Auth if empty.
+func DigestMD5Server(realm, service string, password func(username string) (string, error), qop ...QOP) ServerMechanism {
+	if len(qop) == 0 {
+		qop = defaultQOP
+	}
+	return &digestMD5Server{realm: realm, service: service, password: password, qop: qop}
+}
+
+func (m *digestMD5Server) Next(response []byte) ([]byte, error) {
+	switch m.step {
+	case 0:
+		m.step++
+		return m.challenge()
+	case 1:
+		m.step++
+		d, err := parseDirectives(response)
+		if err != nil {
+			return nil, err
+		}
+		return m.verify(d)
+	}
+	return nil, errors.New("sasl: DIGEST-MD5 is complete")
+}
+
+func (m *digestMD5Server) challenge() ([]byte, error) {
+	var err error
+	if m.nonce, err = newNonce(); err != nil {
+		return nil, err
+	}
+	qop := make([]string, len(m.qop))
+	for i, q := range m.qop {
+		qop[i] = string(q)
+	}
+	var b strings.Builder
+	if m.realm != "" {
+		fmt.Fprintf(&b, "realm=%s,", quote(m.realm))
+	}
+	fmt.Fprintf(&b, "nonce=%s,qop=%s,charset=utf-8,algorithm=md5-sess,maxbuf=%d",
+		quote(m.nonce), quote(strings.Join(qop, ",")), DefaultMaxBuf)
+	if contains(qop, string(AuthConf)) {
+		names := make([]string, len(ciphers))
+		for i, c := range ciphers {
+			names[i] = c.name
+		}
+		fmt.Fprintf(&b, ",cipher=%s", quote(strings.Join(names, ",")))
+	}
+	return []byte(b.String()), nil
+}
+
+func (m *digestMD5Server) verify(d map[string]string) ([]byte, error) {
+	username, realm, uri, nc := d["username"], d["realm"], d["digest-uri"], d["nc"]
+	qop := QOP(d["qop"])
+	if qop == "" {
+		qop = Auth
+	}
+	switch {
+	case username == "":
+		return nil, errors.New("sasl: DIGEST-MD5 response without username")
+	case d["nonce"] != m.nonce:
+		return nil, errors.New("sasl: DIGEST-MD5 response with another nonce")
+	case nc != "00000001":
+		return nil, fmt.Errorf("sasl: DIGEST-MD5 nonce count %q unexpected", nc)
+	case realm != m.realm:
+		return nil, fmt.Errorf("sasl: DIGEST-MD5 realm %q unexpected", realm)
+	case !strings.HasPrefix(uri, m.service+"/"):
+		return nil, fmt.Errorf("sasl: DIGEST-MD5 digest-uri %q is not for %s", uri, m.service)
+	}
+	offered := false
+	for _, q := range m.qop {
+		offered = offered || q == qop
+	}
+	if !offered {
+		return nil, fmt.Errorf("sasl: DIGEST-MD5 quality of protection %q not offered", qop)
+	}
+	cipher := d["cipher"]
+	if qop == AuthConf && chooseCipher([]string{cipher}) == "" {
+		return nil, fmt.Errorf("sasl: DIGEST-MD5 cipher %q not offered", cipher)
+	}
+	maxbuf, err := parseMaxBuf(d["maxbuf"])
+	if err != nil {
+		return nil, err
+	}
+	password, err := m.password(username)
+	if err != nil {
+		return nil, err
+	}
+	cnonce := d["cnonce"]
+	hA1 := digestHA1(username, realm, password, m.nonce, cnonce)
+	if !hmac.Equal([]byte(d["response"]), []byte(digestResponse(hA1, m.nonce, nc, cnonce, qop, "AUTHENTICATE:"+uri))) {
+		return nil, errors.New("sasl: DIGEST-MD5 authentication failed")
+	}
+	if qop != Auth {
+		m.layer = newDigestLayer(hA1, qop, cipher, maxbuf, false)
+	}
+	m.identity, m.complete = username, true
+	return []byte("rspauth=" + digestResponse(hA1, m.nonce, nc, cnonce, qop, ":"+uri)), nil
+}
+
+func (m *digestMD5Server) Complete() bool   { return m.complete }
+func (m *digestMD5Server) Layer() Layer     { return m.layer }
+func (m *digestMD5Server) Identity() string { return m.identity }
+
+func digestHA1(username, realm, password, nonce, cnonce string) []byte {
+	secret := md5.Sum([]byte(username + ":" + realm + ":" + password))
+	hA1 := md5.Sum(append(secret[:], ":"+nonce+":"+cnonce...))
+	return hA1[:]
+}
+
+func digestResponse(hA1 []byte, nonce, nc, cnonce string, qop QOP, a2 string) string {
+	if qop != Auth {
+		a2 += ":00000000000000000000000000000000"
+	}
+	hA2 := md5.Sum([]byte(a2))
+	kd := md5.Sum([]byte(hex.EncodeToString(hA1) + ":" + nonce + ":" + nc + ":" + cnonce + ":" + string(qop) + ":" + hex.EncodeToString(hA2[:])))
+	return hex.EncodeToString(kd[:])
+}
+
+// digestLayer implements the integrity and confidentiality layers, sealing
+// with RC4 for the latter.
+type digestLayer struct {
+	sendKi, recvKi         []byte
+	sendCipher, recvCipher *rc4.Cipher
+	sendSeq, recvSeq       uint32
+	maxWrite               int
+}
+
+// digestOverhead is the MAC, message type and sequence number of a frame.
+const digestOverhead = 10 + 2 + 4
+
+func newDigestLayer(hA1 []byte, qop QOP, cipher string, maxbuf int, client bool) Layer {
+	kic := md5.Sum(append(append([]byte{}, hA1...), "Digest session key to client-to-server signing key magic constant"...))
+	kis := md5.Sum(append(append([]byte{}, hA1...), "Digest session key to server-to-client signing key magic constant"...))
+	l := &digestLayer{sendKi: kic[:], recvKi: kis[:], maxWrite: maxbuf - digestOverhead}
+	if !client {
+		l.sendKi, l.recvKi = l.recvKi, l.sendKi
+	}
+	if qop == AuthConf {
+		n := 0
+		for _, c := range ciphers {
+			if c.name == cipher {
+				n = c.n
+			}
+		}
+		kcc := md5.Sum(append(append([]byte{}, hA1[:n]...), "Digest H(A1) to client-to-server sealing key magic constant"...))
+		kcs := md5.Sum(append(append([]byte{}, hA1[:n]...), "Digest H(A1) to server-to-client sealing key magic constant"...))
+		// rc4.NewCipher fails for key sizes other than 1 to 256 bytes only
+		l.sendCipher, _ = rc4.NewCipher(kcc[:])
+		l.recvCipher, _ = rc4.NewCipher(kcs[:])
+		if !client {
+			l.sendCipher, l.recvCipher = l.recvCipher, l.sendCipher
+		}
+	}
+	return l
+}
+
+func (l *digestLayer) mac(ki []byte, seq uint32, msg []byte) []byte {
+	h := hmac.New(md5.New, ki)
+	binary.Write(h, binary.BigEndian, seq)
+	h.Write(msg)
+	return h.Sum(nil)[:10]
+}
+
+func (l *digestLayer) Wrap(p []byte) ([]byte, error) {
+	out := make([]byte, 0, len(p)+digestOverhead)
+	out = append(append(out, p...), l.mac(l.sendKi, l.sendSeq, p)...)
+	if l.sendCipher != nil {
+		l.sendCipher.XORKeyStream(out, out)
+	}
+	out = binary.BigEndian.AppendUint16(out, 1)
+	out = binary.BigEndian.AppendUint32(out, l.sendSeq)
+	l.sendSeq++
+	return out, nil
+}
+
+func (l *digestLayer) Unwrap(p []byte) ([]byte, error) {
+	if len(p) < digestOverhead {
+		return nil, errors.New("sasl: DIGEST-MD5 frame too short")
+	}
+	n := len(p) - 6
+	if binary.BigEndian.Uint16(p[n:]) != 1 {
+		return nil, errors.New("sasl: DIGEST-MD5 frame of unknown type")
+	}
+	if seq := binary.BigEndian.Uint32(p[n+2:]); seq != l.recvSeq {
+		return nil, fmt.Errorf("sasl: DIGEST-MD5 frame %d out of sequence, expected %d", seq, l.recvSeq)
+	}
+	body := append([]byte(nil), p[:n]...)
+	if l.recvCipher != nil {
+		l.recvCipher.XORKeyStream(body, body)
+	}
+	msg, mac := body[:n-10], body[n-10:]
+	if !hmac.Equal(mac, l.mac(l.recvKi, l.recvSeq, msg)) {
+		return nil, errors.New("sasl: DIGEST-MD5 frame failed the integrity check")
+	}
+	l.recvSeq++
+	return msg, nil
+}
+
+func (l *digestLayer) MaxWrite() int { return l.maxWrite }
+
+func chooseCipher(offered []string) string {
+	for _, c := range ciphers {
+		if contains(offered, c.name) {
+			return c.name
+		}
+	}
+	return ""
+}
+
+func parseMaxBuf(s string) (int, error) {
+	if s == "" {
+		return 65536, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= digestOverhead {
+		return 0, fmt.Errorf("sasl: DIGEST-MD5 maxbuf %q invalid", s)
+	}
+	return n, nil
+}
+
+func newNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// parseDirectives parses the comma separated key=value pairs of a challenge
+// or response, values may be quoted.
+func parseDirectives(b []byte) (map[string]string, error) {
+	d := make(map[string]string)
+	s := string(b)
+	for {
+		s = strings.TrimLeft(s, " \t,")
+		if s == "" {
+			return d, nil
+		}
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("sasl: malformed DIGEST-MD5 directives %q", b)
+		}
+		key := strings.ToLower(strings.TrimSpace(s[:i]))
+		s = strings.TrimLeft(s[i+1:], " \t")
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			var v strings.Builder
+			j := 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				v.WriteByte(s[j])
+			}
+			if j == len(s) {
+				return nil, fmt.Errorf("sasl: unterminated quoted string in %q", b)
+			}
+			value, s = v.String(), s[j+1:]
+		} else {
+			j := strings.IndexByte(s, ',')
+			if j < 0 {
+				j = len(s)
+			}
+			value, s = strings.TrimSpace(s[:j]), s[j:]
+		}
+		// a challenge may offer several realms, the first one is used
+		if _, ok := d[key]; !ok {
+			d[key] = value
+		}
+	}
+}
+
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func splitList(s, empty string) []string {
+	if s == "" {
+		s = empty
+	}
+	var list []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
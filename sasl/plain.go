@@ -0,0 +1,67 @@
+package sasl
+
+import (
+	"bytes"
+	"errors"
+)
+
+type plain struct {
+	authzid, username, password string
+	complete                    bool
+}
+
+// Plain returns the PLAIN mechanism (RFC 4616). authzid, usually empty, is
+// the identity to act as. It sends the password in the clear, so it belongs
+// on a TLS connection.
+func Plain(authzid, username, password string) Mechanism {
+	return &plain{authzid: authzid, username: username, password: password}
+}
+
+func (m *plain) Name() string { return "PLAIN" }
+
+func (m *plain) Start() ([]byte, error) {
+	m.complete = true
+	return []byte(m.authzid + "\x00" + m.username + "\x00" + m.password), nil
+}
+
+func (m *plain) Next([]byte) ([]byte, error) {
+	return nil, errors.New("sasl: PLAIN expects no challenge")
+}
+
+func (m *plain) Complete() bool { return m.complete }
+func (m *plain) Layer() Layer   { return nil }
+
+type plainServer struct {
+	check    func(authzid, username, password string) error
+	identity string
+	complete bool
+}
+
+// PlainServer returns the server side of PLAIN, authenticating with check.
+func PlainServer(check func(authzid, username, password string) error) ServerMechanism {
+	return &plainServer{check: check}
+}
+
+func (m *plainServer) Next(response []byte) ([]byte, error) {
+	if m.complete {
+		return nil, errors.New("sasl: PLAIN is complete")
+	}
+	parts := bytes.Split(response, []byte{0})
+	if len(parts) != 3 {
+		return nil, errors.New("sasl: malformed PLAIN response")
+	}
+	authzid, username, password := string(parts[0]), string(parts[1]), string(parts[2])
+	if err := m.check(authzid, username, password); err != nil {
+		return nil, err
+	}
+	m.identity = username
+	if authzid != "" {
+		m.identity = authzid
+	}
+	m.complete = true
+	return nil, nil
+}
+
+func (m *plainServer) Complete() bool   { return m.complete }
+func (m *plainServer) Layer() Layer     { return nil }
+func (m *plainServer) Identity() string { return m.identity }
@@ -0,0 +1,258 @@
+// Package sasl implements the SASL negotiation of Thrift servers secured with
+// hbase.thrift.security.qop, and the security layer wrapping every frame
+// afterwards. PLAIN and DIGEST-MD5 are built in, other mechanisms such as
+// GSSAPI implement Mechanism.
+package sasl
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// QOP is a quality of protection of the security layer.
+type QOP string
+
+const (
+	// Auth authenticates only, frames are sent as is.
+	Auth QOP = "auth"
+	// AuthInt adds an integrity check to every frame.
+	AuthInt QOP = "auth-int"
+	// AuthConf encrypts every frame as well.
+	AuthConf QOP = "auth-conf"
+)
+
+// DefaultMaxFrameSize bounds negotiation messages and frames read.
+var DefaultMaxFrameSize = 16384000
+
+// Mechanism is the client side of a mechanism, for one connection.
+type Mechanism interface {
+	Name() string
+	// Start returns the initial response, nil for none.
+	Start() ([]byte, error)
+	// Next answers a challenge of the server.
+	Next(challenge []byte) ([]byte, error)
+	Complete() bool
+	// Layer returns the security layer negotiated once complete, nil for
+	// Auth.
+	Layer() Layer
+}
+
+// ServerMechanism is the server side of a mechanism, for one connection.
+type ServerMechanism interface {
+	// Next answers a response of the client, the initial one first.
+	Next(response []byte) ([]byte, error)
+	Complete() bool
+	Layer() Layer
+	// Identity is the user authenticated once complete.
+	Identity() string
+}
+
+// Layer wraps the frames sent and unwraps the frames received.
+type Layer interface {
+	Wrap(p []byte) ([]byte, error)
+	Unwrap(p []byte) ([]byte, error)
+	// MaxWrite is the largest p Wrap accepts, 0 for no limit.
+	MaxWrite() int
+}
+
+type status byte
+
+const (
+	statusStart status = iota + 1
+	statusOK
+	statusBad
+	statusError
+	statusComplete
+)
+
+func writeMessage(w io.Writer, s status, payload []byte) error {
+	buf := make([]byte, 5+len(payload))
+	buf[0] = byte(s)
+	binary.BigEndian.PutUint32(buf[1:5], uint32(len(payload)))
+	copy(buf[5:], payload)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readMessage(r io.Reader) (status, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	payload, err := readPayload(r, binary.BigEndian.Uint32(header[1:]))
+	if err != nil {
+		return 0, nil, err
+	}
+	s := status(header[0])
+	switch s {
+	case statusBad, statusError:
+		return s, payload, fmt.Errorf("sasl: peer failed the negotiation: %s", payload)
+	case statusStart, statusOK, statusComplete:
+		return s, payload, nil
+	}
+	return s, payload, fmt.Errorf("sasl: invalid negotiation status %d", s)
+}
+
+func readPayload(r io.Reader, n uint32) ([]byte, error) {
+	if int64(n) > int64(DefaultMaxFrameSize) {
+		return nil, fmt.Errorf("sasl: frame of %d bytes exceeds the limit", n)
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// fail reports err to the peer before returning it.
+func fail(w io.Writer, flush func() error, s status, err error) error {
+	if writeMessage(w, s, []byte(err.Error())) == nil {
+		flush()
+	}
+	return err
+}
+
+// clientHandshake negotiates m, following TSaslClientTransport of Thrift.
+func clientHandshake(rw io.ReadWriter, flush func() error, m Mechanism) (Layer, error) {
+	initial, err := m.Start()
+	if err != nil {
+		return nil, err
+	}
+	s := statusOK
+	if m.Complete() {
+		s = statusComplete
+	}
+	if err := writeMessage(rw, statusStart, []byte(m.Name())); err != nil {
+		return nil, err
+	}
+	if err := writeMessage(rw, s, initial); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	serverComplete := false
+	for !m.Complete() {
+		s, challenge, err := readMessage(rw)
+		if err != nil {
+			return nil, err
+		}
+		if s != statusOK && s != statusComplete {
+			return nil, fail(rw, flush, statusError, fmt.Errorf("sasl: expected OK or COMPLETE, got status %d", s))
+		}
+		response, err := m.Next(challenge)
+		if err != nil {
+			return nil, fail(rw, flush, statusBad, err)
+		}
+		if s == statusComplete {
+			// the server sends nothing more
+			serverComplete = true
+			continue
+		}
+		s = statusOK
+		if m.Complete() {
+			s = statusComplete
+		}
+		if err := writeMessage(rw, s, response); err != nil {
+			return nil, err
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	if !serverComplete {
+		s, _, err := readMessage(rw)
+		if err != nil {
+			return nil, err
+		}
+		if s != statusComplete {
+			return nil, fail(rw, flush, statusError, fmt.Errorf("sasl: expected COMPLETE, got status %d", s))
+		}
+	}
+	return m.Layer(), nil
+}
+
+// serverHandshake negotiates the mechanism the client starts with.
+func serverHandshake(rw io.ReadWriter, flush func() error, mechanisms map[string]func() ServerMechanism) (ServerMechanism, error) {
+	s, name, err := readMessage(rw)
+	if err != nil {
+		return nil, err
+	}
+	if s != statusStart {
+		return nil, fail(rw, flush, statusError, fmt.Errorf("sasl: expected START, got status %d", s))
+	}
+	newMechanism := mechanisms[string(name)]
+	if newMechanism == nil {
+		return nil, fail(rw, flush, statusBad, fmt.Errorf("sasl: unsupported mechanism %q", name))
+	}
+	m := newMechanism()
+	for !m.Complete() {
+		s, response, err := readMessage(rw)
+		if err != nil {
+			return nil, err
+		}
+		if s != statusOK && s != statusComplete {
+			return nil, fail(rw, flush, statusError, fmt.Errorf("sasl: expected OK or COMPLETE, got status %d", s))
+		}
+		challenge, err := m.Next(response)
+		if err != nil {
+			return nil, fail(rw, flush, statusBad, err)
+		}
+		s = statusOK
+		if m.Complete() {
+			s = statusComplete
+		}
+		if err := writeMessage(rw, s, challenge); err != nil {
+			return nil, err
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// writeFrames sends p as frames of at most l.MaxWrite bytes before wrapping.
+func writeFrames(w io.Writer, l Layer, p []byte) error {
+	for len(p) > 0 {
+		chunk := p
+		if l != nil {
+			if max := l.MaxWrite(); max > 0 && len(chunk) > max {
+				chunk = chunk[:max]
+			}
+		}
+		p = p[len(chunk):]
+		frame := chunk
+		if l != nil {
+			var err error
+			if frame, err = l.Wrap(chunk); err != nil {
+				return err
+			}
+		}
+		buf := make([]byte, 4+len(frame))
+		binary.BigEndian.PutUint32(buf, uint32(len(frame)))
+		copy(buf[4:], frame)
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFrame returns the content of the next frame.
+func readFrame(r io.Reader, l Layer) ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	frame, err := readPayload(r, binary.BigEndian.Uint32(header[:]))
+	if err != nil || l == nil {
+		return frame, err
+	}
+	return l.Unwrap(frame)
+}
+
+var errNotComplete = errors.New("sasl: negotiation not complete")
@@ -0,0 +1,181 @@
+package sasl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func password(username string) (string, error) {
+	if username != "alice" {
+		return "", errors.New("unknown user " + username)
+	}
+	return "secret", nil
+}
+
+func mechanisms(qop ...QOP) map[string]func() ServerMechanism {
+	return map[string]func() ServerMechanism{
+		"PLAIN": func() ServerMechanism {
+			return PlainServer(func(authzid, username, pw string) error {
+				if want, err := password(username); err != nil || pw != want {
+					return errors.New("bad credentials")
+				}
+				return nil
+			})
+		},
+		"DIGEST-MD5": func() ServerMechanism { return DigestMD5Server("hbase.example.com", "hbase", password, qop...) },
+	}
+}
+
+func TestDigestMD5_RFC2831(t *testing.T) {
+	m := DigestMD5("chris", "secret", "imap", "elwood.innosoft.com", Auth).(*digestMD5)
+	m.cnonce = "OA6MHXh6VqTrRk"
+	resp, err := m.Next([]byte(`realm="elwood.innosoft.com",nonce="OA6MG9tEQGm2hh",qop="auth",algorithm=md5-sess,charset=utf-8`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := parseDirectives(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d["response"] != "d388dad90d4bbd760a152321f2143af7" || d["digest-uri"] != "imap/elwood.innosoft.com" || d["qop"] != "auth" {
+		t.Fatalf("response = %s", resp)
+	}
+	if _, err := m.Next([]byte("rspauth=ea40f60335c427b5527b84dbabcdfffd")); err != nil || !m.Complete() {
+		t.Fatalf("Next = %v, complete %v", err, m.Complete())
+	}
+	if m.Layer() != nil {
+		t.Fatal("wanted no layer for auth")
+	}
+}
+
+func TestClient(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		mech  Mechanism
+		offer []QOP
+		layer bool
+		err   string
+	}{
+		{name: "plain", mech: Plain("", "alice", "secret")},
+		{name: "plain bad password", mech: Plain("", "alice", "guess"), err: "bad credentials"},
+		{name: "auth", mech: DigestMD5("alice", "secret", "hbase", "localhost", Auth)},
+		{name: "auth-int", mech: DigestMD5("alice", "secret", "hbase", "localhost", AuthInt), layer: true},
+		{name: "auth-conf", mech: DigestMD5("alice", "secret", "hbase", "localhost"), layer: true},
+		{name: "negotiated", mech: DigestMD5("alice", "secret", "hbase", "localhost"), offer: []QOP{AuthInt, Auth}, layer: true},
+		{name: "no common qop", mech: DigestMD5("alice", "secret", "hbase", "localhost", AuthConf), offer: []QOP{Auth}, err: "no quality of protection in common"},
+		{name: "digest bad password", mech: DigestMD5("alice", "guess", "hbase", "localhost"), err: "authentication failed"},
+		{name: "wrong service", mech: DigestMD5("alice", "secret", "imap", "localhost"), err: "is not for hbase"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cc, sc := net.Pipe()
+			defer cc.Close()
+			defer sc.Close()
+			done := make(chan error, 1)
+			go func() {
+				s, err := Server(sc, mechanisms(tc.offer...))
+				if err != nil {
+					done <- err
+					return
+				}
+				if s.Identity() != "alice" {
+					done <- errors.New("identity " + s.Identity())
+					return
+				}
+				// echo
+				_, err = io.Copy(s, io.LimitReader(s, 200000))
+				done <- err
+			}()
+
+			c, err := Client(cc, tc.mech)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("Client = %v, wanted %q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (c.layer != nil) != tc.layer {
+				t.Fatalf("layer = %v", c.layer)
+			}
+			// larger than maxbuf, so that frames are split
+			sent := bytes.Repeat([]byte("0123456789"), 20000)
+			go c.Write(sent)
+			got := make([]byte, len(sent))
+			if _, err := io.ReadFull(c, got); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, sent) {
+				t.Fatal("echo differs")
+			}
+			if err := <-done; err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestDigestLayer_Tampered(t *testing.T) {
+	hA1 := digestHA1("alice", "", "secret", "nonce", "cnonce")
+	for _, qop := range []QOP{AuthInt, AuthConf} {
+		client := newDigestLayer(hA1, qop, "rc4", DefaultMaxBuf, true)
+		server := newDigestLayer(hA1, qop, "rc4", DefaultMaxBuf, false)
+		frame, _ := client.Wrap([]byte("hello"))
+		if qop == AuthConf && bytes.Contains(frame, []byte("hello")) {
+			t.Fatal("auth-conf frame is not encrypted")
+		}
+		frame[0] ^= 1
+		if _, err := server.Unwrap(frame); err == nil {
+			t.Fatalf("%s: wanted an integrity error", qop)
+		}
+	}
+}
+
+func TestTSaslClientTransport(t *testing.T) {
+	ctx := context.Background()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := memhbase.Listen(hbase.NewProcessor(memhbase.New()), ln, func(conn net.Conn) (thrift.TTransport, error) {
+		c, err := Server(conn, mechanisms(AuthConf))
+		if err != nil {
+			return nil, err
+		}
+		return thrift.NewTBufferedTransport(thrift.NewTSocketFromConnConf(c, nil), 4096), nil
+	})
+	defer l.Close()
+
+	trans := NewTSaslClientTransport(thrift.NewTSocketConf(l.Addr, nil), DigestMD5("alice", "secret", "hbase", "localhost"))
+	if err := trans.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer trans.Close()
+	c := hbase.NewClientFactory(trans, thrift.NewTBinaryProtocolFactoryConf(nil))
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = []byte("f:")
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	names, err := c.GetTableNames(ctx)
+	if err != nil || len(names) != 1 || string(names[0]) != "t" {
+		t.Fatalf("GetTableNames = %q, %v", names, err)
+	}
+
+	trans = NewTSaslClientTransport(thrift.NewTSocketConf(l.Addr, nil), DigestMD5("alice", "guess", "hbase", "localhost"))
+	if err := trans.Open(); err == nil {
+		trans.Close()
+		t.Fatal("wanted an error for a bad password")
+	} else if _, ok := err.(thrift.TTransportException); !ok {
+		t.Fatalf("wanted TTransportException, got %T", err)
+	}
+}
@@ -0,0 +1,90 @@
+package sasl
+
+import (
+	"bytes"
+	"context"
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// TSaslClientTransport negotiates a mechanism when opened, then sends every
+// flush as one frame or more.
+type TSaslClientTransport struct {
+	trans thrift.TTransport
+	mech  Mechanism
+	layer Layer
+	open  bool
+	rbuf  []byte
+	wbuf  bytes.Buffer
+}
+
+// NewTSaslClientTransport returns a transport negotiating mechanism over
+// trans, e.g. a thrift.TSocket. A Mechanism serves one connection, so a
+// transport opened again needs a new one.
+func NewTSaslClientTransport(trans thrift.TTransport, mechanism Mechanism) *TSaslClientTransport {
+	return &TSaslClientTransport{trans: trans, mech: mechanism}
+}
+
+func (t *TSaslClientTransport) Open() error {
+	if t.open {
+		return thrift.NewTTransportException(thrift.ALREADY_OPEN, "sasl: transport already open")
+	}
+	if !t.trans.IsOpen() {
+		if err := t.trans.Open(); err != nil {
+			return err
+		}
+	}
+	flush := func() error { return t.trans.Flush(context.Background()) }
+	l, err := clientHandshake(t.trans, flush, t.mech)
+	if err != nil {
+		return thrift.NewTTransportExceptionFromError(err)
+	}
+	t.layer, t.open = l, true
+	return nil
+}
+
+func (t *TSaslClientTransport) IsOpen() bool {
+	return t.open && t.trans.IsOpen()
+}
+
+func (t *TSaslClientTransport) Close() error {
+	t.open = false
+	t.rbuf = nil
+	t.wbuf.Reset()
+	return t.trans.Close()
+}
+
+func (t *TSaslClientTransport) Read(p []byte) (int, error) {
+	if !t.open {
+		return 0, thrift.NewTTransportException(thrift.NOT_OPEN, errNotComplete.Error())
+	}
+	for len(t.rbuf) == 0 {
+		frame, err := readFrame(t.trans, t.layer)
+		if err != nil {
+			return 0, thrift.NewTTransportExceptionFromError(err)
+		}
+		t.rbuf = frame
+	}
+	n := copy(p, t.rbuf)
+	t.rbuf = t.rbuf[n:]
+	return n, nil
+}
+
+func (t *TSaslClientTransport) Write(p []byte) (int, error) {
+	return t.wbuf.Write(p)
+}
+
+func (t *TSaslClientTransport) Flush(ctx context.Context) error {
+	if !t.open {
+		return thrift.NewTTransportException(thrift.NOT_OPEN, errNotComplete.Error())
+	}
+	defer t.wbuf.Reset()
+	if err := writeFrames(t.trans, t.layer, t.wbuf.Bytes()); err != nil {
+		return thrift.NewTTransportExceptionFromError(err)
+	}
+	return t.trans.Flush(ctx)
+}
+
+// RemainingBytes is unknown, frames follow one another.
+func (t *TSaslClientTransport) RemainingBytes() uint64 {
+	return ^uint64(0)
+}
@@ -0,0 +1,170 @@
+package hbase
+
+import (
+	"bytes"
+	"context"
+	"io"
+)
+
+var (
+	DefaultScannerCaching   int32 = 100
+	DefaultScannerMaxReopen       = 3
+)
+
+// Scanner iterates over the rows of a TScan, fetching them from the server
+// in batches of TScan.Caching rows (DefaultScannerCaching if unset).
+//
+// When the server no longer knows the scanner, after a gateway restart or a
+// scanner lease expiry, Scanner opens it again after the last row fetched.
+type Scanner struct {
+	c          Hbase
+	tableName  []byte
+	scan       *TScan
+	attributes map[string][]byte
+	maxReopen  int
+
+	id     ScannerID
+	opened bool
+	rows   []*TRowResult_
+	done   bool
+	// last is the last row fetched, nil before the first
+	last    []byte
+	reopens int
+	// skip is a row dropped at the start of the scanner opened next, lower
+	// the inclusive lower bound of a reversed scan, see ScanReverse
+	skip, lower []byte
+}
+
+func NewScanner(c Hbase, tableName []byte, scan *TScan, attributes map[string][]byte) *Scanner {
+	if scan == nil {
+		scan = NewTScan()
+	}
+	return &Scanner{
+		c:          c,
+		tableName:  tableName,
+		scan:       scan,
+		attributes: attributes,
+		maxReopen:  DefaultScannerMaxReopen,
+	}
+}
+
+// SetMaxReopen sets how many times in a row the scanner is opened again
+// without fetching rows before the error is returned, 0 never reopens.
+func (s *Scanner) SetMaxReopen(maxReopen int) {
+	if maxReopen >= 0 {
+		s.maxReopen = maxReopen
+	}
+}
+
+func (s *Scanner) caching() int32 {
+	if s.scan.Caching != nil && *s.scan.Caching > 0 {
+		return *s.scan.Caching
+	}
+	return DefaultScannerCaching
+}
+
+// Next returns the next row, or io.EOF when the scan is exhausted.
+func (s *Scanner) Next(ctx context.Context) (*TRowResult_, error) {
+	for len(s.rows) == 0 {
+		if s.done {
+			return nil, io.EOF
+		}
+		if err := s.fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+	r := s.rows[0]
+	s.rows = s.rows[1:]
+	return r, nil
+}
+
+func (s *Scanner) fetch(ctx context.Context) (err error) {
+	if !s.opened {
+		if s.id, err = s.c.ScannerOpenWithScan(ctx, s.tableName, s.resumeScan(), s.attributes); err != nil {
+			return err
+		}
+		s.opened = true
+	}
+	rows, err := s.c.ScannerGetList(ctx, s.id, s.caching())
+	if _, ok := err.(*IllegalArgument); ok && s.reopens < s.maxReopen {
+		// the scanner is gone, its ID is no use to ScannerClose either
+		s.reopens++
+		s.opened = false
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.reopens = 0
+	// a short page is not the last one, a gateway or a proxy may return
+	// fewer rows than asked for before the end
+	exhausted := len(rows) == 0
+	if s.skip != nil && len(rows) > 0 && bytes.Equal(rows[0].Row, s.skip) {
+		rows = rows[1:]
+	}
+	s.skip = nil
+	if s.lower != nil {
+		for i, r := range rows {
+			if bytes.Compare(r.Row, s.lower) < 0 {
+				rows, exhausted = rows[:i], true
+				break
+			}
+		}
+	}
+	if len(rows) > 0 {
+		s.last = rows[len(rows)-1].Row
+	}
+	s.rows = rows
+	if exhausted {
+		// exhausted, a failed close does not invalidate the rows we already have
+		s.Close(ctx)
+	}
+	return nil
+}
+
+func (s *Scanner) isReversed() bool {
+	return s.scan.Reversed != nil && *s.scan.Reversed
+}
+
+// resumeScan returns the scan of the rows after the last one fetched. A
+// forward scan starts at the next possible key, the last row with a zero
+// byte appended. A reversed scan has no such key, and starts at the last row.
+func (s *Scanner) resumeScan() *TScan {
+	if s.last == nil {
+		return s.scan
+	}
+	scan := *s.scan
+	if s.isReversed() {
+		// the last row is dropped again
+		scan.StartRow, s.skip = s.last, s.last
+	} else {
+		scan.StartRow = append(append(make([]byte, 0, len(s.last)+1), s.last...), 0)
+	}
+	return &scan
+}
+
+// Close releases the server side scanner. It is safe to call more than once.
+func (s *Scanner) Close(ctx context.Context) error {
+	if !s.opened {
+		return nil
+	}
+	s.opened = false
+	s.done = true
+	return s.c.ScannerClose(ctx, s.id)
+}
+
+// ScanAll is a convenience to run a scan to completion.
+func ScanAll(ctx context.Context, c Hbase, tableName []byte, scan *TScan, attributes map[string][]byte) (r []*TRowResult_, err error) {
+	s := NewScanner(c, tableName, scan, attributes)
+	defer s.Close(ctx)
+	for {
+		row, err := s.Next(ctx)
+		if err == io.EOF {
+			return r, nil
+		}
+		if err != nil {
+			return r, err
+		}
+		r = append(r, row)
+	}
+}
@@ -0,0 +1,83 @@
+package hbase_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/apache/thrift/lib/go/thrift"
+	"testing"
+)
+
+// expiring drops the scanners of the server before the ScannerGetList calls
+// listed in expire, counted from 1.
+type expiring struct {
+	*memhbase.Server
+	calls  int
+	expire map[int]bool
+}
+
+func (e *expiring) ScannerGetList(ctx context.Context, id hbase.ScannerID, nbRows int32) ([]*hbase.TRowResult_, error) {
+	e.calls++
+	if e.expire[e.calls] {
+		e.ExpireScanners()
+	}
+	return e.Server.ScannerGetList(ctx, id, nbRows)
+}
+
+func newExpiring(t *testing.T, rows int, expire ...int) *expiring {
+	s := memhbase.NewTable(t, "t", "f")
+	s.PutRows(t, "t", "f:a", rows)
+	e := &expiring{Server: s, expire: make(map[int]bool)}
+	for _, n := range expire {
+		e.expire[n] = true
+	}
+	return e
+}
+
+func TestScanner_Reopen(t *testing.T) {
+	ctx := context.Background()
+	for _, reversed := range []bool{false, true} {
+		e := newExpiring(t, 10, 2, 4, 5)
+		scan := hbase.NewTScan()
+		scan.Caching = thrift.Int32Ptr(3)
+		scan.Reversed = &reversed
+		rows, err := hbase.ScanAll(ctx, e, []byte("t"), scan, nil)
+		if err != nil {
+			t.Fatalf("reversed %v: %v", reversed, err)
+		}
+		if len(rows) != 10 {
+			t.Fatalf("reversed %v: %d rows", reversed, len(rows))
+		}
+		for i, r := range rows {
+			want := i
+			if reversed {
+				want = 9 - i
+			}
+			if string(r.Row) != fmt.Sprintf("r%02d", want) {
+				t.Fatalf("reversed %v: row %d is %s", reversed, i, r.Row)
+			}
+		}
+	}
+}
+
+func TestScanner_MaxReopen(t *testing.T) {
+	ctx := context.Background()
+	e := newExpiring(t, 10, 2, 3, 4)
+	scan := hbase.NewTScan()
+	scan.Caching = thrift.Int32Ptr(3)
+
+	s := hbase.NewScanner(e, []byte("t"), scan, nil)
+	s.SetMaxReopen(2)
+	defer s.Close(ctx)
+	var err error
+	for i := 0; err == nil; i++ {
+		_, err = s.Next(ctx)
+		if i > 10 {
+			t.Fatal("scan did not fail")
+		}
+	}
+	if _, ok := err.(*hbase.IllegalArgument); !ok {
+		t.Fatalf("wanted IllegalArgument after 2 reopens, got %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package thrift2_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/He11oLx/hbase/thrift2"
+	"testing"
+)
+
+// handler keeps the latest put of every row and serves the calls used below.
+type handler struct {
+	thrift2.THBaseService
+	rows map[string]*thrift2.TPut
+}
+
+func (h *handler) Put(ctx context.Context, table []byte, tput *thrift2.TPut) error {
+	if string(table) != "t" {
+		msg := "table not found: " + string(table)
+		return &thrift2.TIOError{Message: &msg}
+	}
+	h.rows[string(tput.Row)] = tput
+	return nil
+}
+
+func (h *handler) GetMultiple(ctx context.Context, table []byte, tgets []*thrift2.TGet) ([]*thrift2.TResult_, error) {
+	r := make([]*thrift2.TResult_, 0, len(tgets))
+	for _, g := range tgets {
+		res := &thrift2.TResult_{ColumnValues: []*thrift2.TColumnValue{}}
+		if put, ok := h.rows[string(g.Row)]; ok {
+			res.Row, res.ColumnValues = put.Row, put.ColumnValues
+		}
+		r = append(r, res)
+	}
+	return r, nil
+}
+
+func (h *handler) CheckAndMutate(ctx context.Context, table []byte, row []byte, family []byte, qualifier []byte, compareOp thrift2.TCompareOp, value []byte, rowMutations *thrift2.TRowMutations) (bool, error) {
+	put, ok := h.rows[string(row)]
+	if !ok || compareOp != thrift2.TCompareOp_EQUAL {
+		return false, nil
+	}
+	for _, cv := range put.ColumnValues {
+		if bytes.Equal(cv.Family, family) && bytes.Equal(cv.Qualifier, qualifier) && bytes.Equal(cv.Value, value) {
+			for _, m := range rowMutations.Mutations {
+				if m.IsSetDeleteSingle() {
+					delete(h.rows, string(row))
+				}
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	h := &handler{rows: make(map[string]*thrift2.TPut)}
+	c := thrift2.NewClient(memhbase.NewProcessorLoopback(thrift2.NewProcessor(h)))
+
+	ts := int64(1000)
+	put := &thrift2.TPut{
+		Row: []byte("r1"),
+		ColumnValues: []*thrift2.TColumnValue{
+			{Family: []byte("f"), Qualifier: []byte("q"), Value: []byte("v1"), Timestamp: &ts},
+		},
+		Durability: thrift2.TDurabilityPtr(thrift2.TDurability_SKIP_WAL),
+	}
+	if err := c.Put(ctx, []byte("t"), put); err != nil {
+		t.Fatal(err)
+	}
+	if err, ok := c.Put(ctx, []byte("missing"), put).(*thrift2.TIOError); !ok || err.GetMessage() != "table not found: missing" {
+		t.Fatalf("wanted TIOError, got %v", err)
+	}
+
+	results, err := c.GetMultiple(ctx, []byte("t"), []*thrift2.TGet{{Row: []byte("r1")}, {Row: []byte("r2")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || len(results[0].ColumnValues) != 1 || results[0].ColumnValues[0].GetTimestamp() != 1000 {
+		t.Fatalf("results %v", results)
+	}
+	if results[1].IsSetRow() || len(results[1].ColumnValues) != 0 {
+		t.Fatalf("wanted an empty result for r2, got %v", results[1])
+	}
+
+	mutations := &thrift2.TRowMutations{
+		Row:       []byte("r1"),
+		Mutations: []*thrift2.TMutation{{DeleteSingle: &thrift2.TDelete{Row: []byte("r1"), DeleteType: thrift2.TDeleteType_DELETE_COLUMNS}}},
+	}
+	ok, err := c.CheckAndMutate(ctx, []byte("t"), []byte("r1"), []byte("f"), []byte("q"), thrift2.TCompareOp_EQUAL, []byte("v2"), mutations)
+	if err != nil || ok {
+		t.Fatalf("mismatched CheckAndMutate = %v, %v", ok, err)
+	}
+	ok, err = c.CheckAndMutate(ctx, []byte("t"), []byte("r1"), []byte("f"), []byte("q"), thrift2.TCompareOp_EQUAL, []byte("v1"), mutations)
+	if err != nil || !ok {
+		t.Fatalf("CheckAndMutate = %v, %v", ok, err)
+	}
+	if _, ok := h.rows["r1"]; ok {
+		t.Fatal("r1 was not deleted")
+	}
+
+	// a union must have exactly one member set
+	mutations.Mutations = []*thrift2.TMutation{{}}
+	if _, err = c.CheckAndMutate(ctx, []byte("t"), []byte("r1"), []byte("f"), []byte("q"), thrift2.TCompareOp_EQUAL, nil, mutations); err == nil {
+		t.Fatal("empty TMutation was written")
+	}
+}
@@ -0,0 +1,3 @@
+package thrift2
+
+//go:generate go run ../internal/thriftgen -service THBaseService hbase.thrift
@@ -0,0 +1,115 @@
+package timeseries
+
+import (
+	"sort"
+	"time"
+)
+
+// Aggregator reduces the values of a downsampling bucket, or of several
+// series at the same timestamp, to a single value.
+type Aggregator func(values []float64) float64
+
+var (
+	Sum Aggregator = func(values []float64) float64 {
+		var s float64
+		for _, v := range values {
+			s += v
+		}
+		return s
+	}
+	Avg Aggregator = func(values []float64) float64 {
+		return Sum(values) / float64(len(values))
+	}
+	Min Aggregator = func(values []float64) float64 {
+		m := values[0]
+		for _, v := range values[1:] {
+			if v < m {
+				m = v
+			}
+		}
+		return m
+	}
+	Max Aggregator = func(values []float64) float64 {
+		m := values[0]
+		for _, v := range values[1:] {
+			if v > m {
+				m = v
+			}
+		}
+		return m
+	}
+)
+
+type DataPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// downsample groups points into buckets of width interval aligned to the
+// epoch and reduces every bucket with agg. Points must be sorted.
+func downsample(points []DataPoint, interval time.Duration, agg Aggregator) []DataPoint {
+	if interval <= 0 || len(points) == 0 {
+		return points
+	}
+	var (
+		r      []DataPoint
+		values []float64
+		bucket time.Time
+	)
+	for i, p := range points {
+		b := truncate(p.Timestamp, interval)
+		if i > 0 && !b.Equal(bucket) {
+			r = append(r, DataPoint{Timestamp: bucket, Value: agg(values)})
+			values = values[:0]
+		}
+		bucket = b
+		values = append(values, p.Value)
+	}
+	return append(r, DataPoint{Timestamp: bucket, Value: agg(values)})
+}
+
+func truncate(t time.Time, interval time.Duration) time.Time {
+	ns, iv := t.UnixNano(), int64(interval)
+	return time.Unix(0, ns-((ns%iv)+iv)%iv)
+}
+
+// merge aggregates several series point by point. Series are not
+// interpolated, so only values sharing a timestamp are combined; downsampling
+// first lines the series up.
+func merge(series [][]DataPoint, agg Aggregator) []DataPoint {
+	if len(series) == 1 {
+		return series[0]
+	}
+	byTime := make(map[int64][]float64)
+	for _, points := range series {
+		for _, p := range points {
+			ts := p.Timestamp.UnixNano()
+			byTime[ts] = append(byTime[ts], p.Value)
+		}
+	}
+	r := make([]DataPoint, 0, len(byTime))
+	for ts, values := range byTime {
+		r = append(r, DataPoint{Timestamp: time.Unix(0, ts), Value: agg(values)})
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].Timestamp.Before(r[j].Timestamp) })
+	return r
+}
+
+// rate converts a series to its per-second rate of change.
+func rate(points []DataPoint) []DataPoint {
+	if len(points) < 2 {
+		return nil
+	}
+	r := make([]DataPoint, 0, len(points)-1)
+	for i := 1; i < len(points); i++ {
+		dt := points[i].Timestamp.Sub(points[i-1].Timestamp).Seconds()
+		if dt <= 0 {
+			continue
+		}
+		r = append(r, DataPoint{
+			Timestamp: points[i].Timestamp,
+			Value:     (points[i].Value - points[i-1].Value) / dt,
+		})
+	}
+	return r
+}
@@ -0,0 +1,130 @@
+package timeseries
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Row keys follow the OpenTSDB layout without the UID indirection:
+//
+//	metric 0x00 base-hour(uint32 seconds) tagk=tagv 0x00 tagk=tagv ...
+//
+// Tags are sorted by key so that a series always maps to the same row. The
+// qualifier is the millisecond offset into the hour as a big-endian uint32
+// and the value is the IEEE 754 bits of a float64.
+
+const bucketSpan = time.Hour
+
+var (
+	ErrInvalidMetric = errors.New("timeseries: metric must be non-empty and must not contain 0x00")
+	ErrInvalidTag    = errors.New("timeseries: tags must be non-empty, must not contain 0x00 and keys must not contain '='")
+	ErrInvalidRowKey = errors.New("timeseries: malformed row key")
+)
+
+func validMetric(metric string) bool {
+	return metric != "" && strings.IndexByte(metric, 0) < 0
+}
+
+func validTag(k, v string) bool {
+	return k != "" && v != "" && strings.IndexByte(k, 0) < 0 && strings.IndexByte(v, 0) < 0 &&
+		strings.IndexByte(k, '=') < 0
+}
+
+func baseTime(t time.Time) int64 {
+	ms := t.UnixNano() / int64(time.Millisecond)
+	span := int64(bucketSpan / time.Millisecond)
+	return ms - ((ms%span)+span)%span
+}
+
+// metricPrefix returns the common prefix of every row of the metric.
+func metricPrefix(metric string) []byte {
+	b := make([]byte, 0, len(metric)+1)
+	b = append(b, metric...)
+	return append(b, 0)
+}
+
+// bucketKey returns the key prefix of the metric's rows for the hour containing t.
+func bucketKey(metric string, t time.Time) []byte {
+	b := metricPrefix(metric)
+	var ts [4]byte
+	binary.BigEndian.PutUint32(ts[:], uint32(baseTime(t)/1000))
+	return append(b, ts[:]...)
+}
+
+func rowKey(metric string, tags map[string]string, t time.Time) ([]byte, error) {
+	if !validMetric(metric) {
+		return nil, ErrInvalidMetric
+	}
+	keys := make([]string, 0, len(tags))
+	for k, v := range tags {
+		if !validTag(k, v) {
+			return nil, ErrInvalidTag
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	b := bucketKey(metric, t)
+	for i, k := range keys {
+		if i > 0 {
+			b = append(b, 0)
+		}
+		b = append(b, k...)
+		b = append(b, '=')
+		b = append(b, tags[k]...)
+	}
+	return b, nil
+}
+
+// parseRowKey returns the metric, tags and base time of a row key.
+func parseRowKey(key []byte) (metric string, tags map[string]string, base int64, err error) {
+	i := bytes.IndexByte(key, 0)
+	if i < 0 || len(key) < i+5 {
+		return "", nil, 0, ErrInvalidRowKey
+	}
+	metric = string(key[:i])
+	base = int64(binary.BigEndian.Uint32(key[i+1:i+5])) * 1000
+	tags = make(map[string]string)
+	if rest := key[i+5:]; len(rest) > 0 {
+		for _, kv := range bytes.Split(rest, []byte{0}) {
+			j := bytes.IndexByte(kv, '=')
+			if j <= 0 {
+				return "", nil, 0, ErrInvalidRowKey
+			}
+			tags[string(kv[:j])] = string(kv[j+1:])
+		}
+	}
+	return metric, tags, base, nil
+}
+
+func qualifier(t time.Time) []byte {
+	ms := t.UnixNano() / int64(time.Millisecond)
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(ms-baseTime(t)))
+	return b[:]
+}
+
+// parseQualifier returns the millisecond offset encoded by qualifier.
+func parseQualifier(q string) (int64, bool) {
+	if len(q) != 4 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint32([]byte(q))), true
+}
+
+func encodeValue(v float64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], math.Float64bits(v))
+	return b[:]
+}
+
+func decodeValue(b []byte) (float64, bool) {
+	if len(b) != 8 {
+		return 0, false
+	}
+	return math.Float64frombits(binary.BigEndian.Uint64(b)), true
+}
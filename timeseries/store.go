@@ -0,0 +1,207 @@
+// Package timeseries stores metric points in HBase using an OpenTSDB-like
+// schema: one row per metric, tag set and hour, one column per point.
+package timeseries
+
+import (
+	"context"
+	"errors"
+	"github.com/He11oLx/hbase"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+var DefaultFamily = "t"
+
+var ErrNoAggregator = errors.New("timeseries: query needs an aggregator")
+
+type Point struct {
+	Metric    string
+	Tags      map[string]string
+	Timestamp time.Time
+	Value     float64
+}
+
+type Store struct {
+	c      hbase.Hbase
+	table  []byte
+	family string
+}
+
+// NewStore returns a store writing to table, which must have the column
+// family DefaultFamily (or the one set with SetFamily).
+func NewStore(c hbase.Hbase, table string) *Store {
+	return &Store{
+		c:      c,
+		table:  []byte(table),
+		family: DefaultFamily,
+	}
+}
+
+func (s *Store) SetFamily(family string) {
+	s.family = family
+}
+
+func (s *Store) column(q []byte) []byte {
+	c := make([]byte, 0, len(s.family)+1+len(q))
+	c = append(c, s.family...)
+	c = append(c, ':')
+	return append(c, q...)
+}
+
+// Put writes the points with a single MutateRows call, one BatchMutation
+// per row.
+func (s *Store) Put(ctx context.Context, points ...Point) error {
+	if len(points) == 0 {
+		return nil
+	}
+	var batches []*hbase.BatchMutation
+	byRow := make(map[string]*hbase.BatchMutation)
+	for _, p := range points {
+		key, err := rowKey(p.Metric, p.Tags, p.Timestamp)
+		if err != nil {
+			return err
+		}
+		b, ok := byRow[string(key)]
+		if !ok {
+			b = &hbase.BatchMutation{Row: key}
+			byRow[string(key)] = b
+			batches = append(batches, b)
+		}
+		m := hbase.NewMutation()
+		m.Column = s.column(qualifier(p.Timestamp))
+		m.Value = encodeValue(p.Value)
+		b.Mutations = append(b.Mutations, m)
+	}
+	return s.c.MutateRows(ctx, s.table, batches, nil)
+}
+
+// Query selects the points of Metric in [Start, End).
+//
+// Tags filters the series: a value of "*" only requires the tag to be
+// present. Every series is downsampled first, then series are aggregated per
+// distinct value of the GroupBy tags. Rate turns the result into a
+// per-second rate of change.
+type Query struct {
+	Metric     string
+	Tags       map[string]string
+	GroupBy    []string
+	Start, End time.Time
+	Downsample time.Duration
+	Aggregator Aggregator
+	Rate       bool
+}
+
+type Series struct {
+	Metric string
+	// only the GroupBy tags
+	Tags   map[string]string
+	Points []DataPoint
+}
+
+func (q *Query) match(tags map[string]string) bool {
+	for k, v := range q.Tags {
+		got, ok := tags[k]
+		if !ok || (v != "*" && v != got) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *Query) group(tags map[string]string) (string, map[string]string) {
+	g := make(map[string]string, len(q.GroupBy))
+	parts := make([]string, 0, len(q.GroupBy))
+	for _, k := range q.GroupBy {
+		g[k] = tags[k]
+		parts = append(parts, k+"="+tags[k])
+	}
+	return strings.Join(parts, "\x00"), g
+}
+
+func (s *Store) Query(ctx context.Context, q *Query) ([]*Series, error) {
+	if q.Aggregator == nil {
+		return nil, ErrNoAggregator
+	}
+	if !validMetric(q.Metric) {
+		return nil, ErrInvalidMetric
+	}
+	start := q.Start.UnixNano() / int64(time.Millisecond)
+	end := q.End.UnixNano() / int64(time.Millisecond)
+	scan := hbase.NewTScan()
+	scan.StartRow = bucketKey(q.Metric, q.Start)
+	scan.StopRow = bucketKey(q.Metric, q.End.Add(bucketSpan))
+	scan.Columns = [][]byte{[]byte(s.family)}
+
+	// series keyed by the full tag set, in scan order
+	series := make(map[string][]DataPoint)
+	var order []string
+	tagsOf := make(map[string]map[string]string)
+
+	scanner := hbase.NewScanner(s.c, s.table, scan, nil)
+	defer scanner.Close(ctx)
+	for {
+		row, err := scanner.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		metric, tags, base, err := parseRowKey(row.Row)
+		if err != nil {
+			return nil, err
+		}
+		if metric != q.Metric || !q.match(tags) {
+			continue
+		}
+		id := string(row.Row[len(metric)+5:])
+		if _, ok := tagsOf[id]; !ok {
+			order = append(order, id)
+			tagsOf[id] = tags
+		}
+		for col, cell := range row.Columns {
+			off, ok := parseQualifier(col[strings.IndexByte(col, ':')+1:])
+			if !ok {
+				continue
+			}
+			ts := base + off
+			if ts < start || ts >= end {
+				continue
+			}
+			v, ok := decodeValue(cell.Value)
+			if !ok {
+				continue
+			}
+			series[id] = append(series[id], DataPoint{Timestamp: time.Unix(0, ts*int64(time.Millisecond)), Value: v})
+		}
+	}
+
+	groups := make(map[string]*Series)
+	members := make(map[string][][]DataPoint)
+	var groupOrder []string
+	for _, id := range order {
+		points := series[id]
+		if len(points) == 0 {
+			continue
+		}
+		sort.Slice(points, func(i, j int) bool { return points[i].Timestamp.Before(points[j].Timestamp) })
+		key, tags := q.group(tagsOf[id])
+		if _, ok := groups[key]; !ok {
+			groups[key] = &Series{Metric: q.Metric, Tags: tags}
+			groupOrder = append(groupOrder, key)
+		}
+		members[key] = append(members[key], downsample(points, q.Downsample, q.Aggregator))
+	}
+	r := make([]*Series, 0, len(groupOrder))
+	for _, key := range groupOrder {
+		g := groups[key]
+		g.Points = merge(members[key], q.Aggregator)
+		if q.Rate {
+			g.Points = rate(g.Points)
+		}
+		r = append(r, g)
+	}
+	return r, nil
+}
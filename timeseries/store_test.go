@@ -0,0 +1,108 @@
+package timeseries
+
+import (
+	"context"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"testing"
+	"time"
+)
+
+func newStore(t *testing.T) *Store {
+	return NewStore(memhbase.NewTable(t, "tsdb", DefaultFamily), "tsdb")
+}
+
+func TestRowKey(t *testing.T) {
+	ts := time.Date(2018, 10, 1, 12, 34, 56, 0, time.UTC)
+	key, err := rowKey("cpu", map[string]string{"host": "a", "dc": "x"}, ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metric, tags, base, err := parseRowKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metric != "cpu" || tags["host"] != "a" || tags["dc"] != "x" || len(tags) != 2 {
+		t.Fatalf("got %s %v", metric, tags)
+	}
+	if want := time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC); base != want.Unix()*1000 {
+		t.Fatalf("base %d, wanted %d", base, want.Unix()*1000)
+	}
+	off, _ := parseQualifier(string(qualifier(ts)))
+	if off != (34*60+56)*1000 {
+		t.Fatalf("offset %d", off)
+	}
+	if _, err := rowKey("cpu", map[string]string{"a=b": "c"}, ts); err != ErrInvalidTag {
+		t.Fatalf("wanted ErrInvalidTag, got %v", err)
+	}
+}
+
+func TestStore_Query(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t)
+	start := time.Date(2018, 10, 1, 11, 58, 0, 0, time.UTC)
+	var points []Point
+	for i := 0; i < 6; i++ {
+		ts := start.Add(time.Duration(i) * time.Minute)
+		points = append(points,
+			Point{Metric: "req", Tags: map[string]string{"host": "a"}, Timestamp: ts, Value: float64(i)},
+			Point{Metric: "req", Tags: map[string]string{"host": "b"}, Timestamp: ts, Value: float64(10 * i)},
+			Point{Metric: "other", Tags: map[string]string{"host": "a"}, Timestamp: ts, Value: 100},
+		)
+	}
+	if err := s.Put(ctx, points...); err != nil {
+		t.Fatal(err)
+	}
+
+	series, err := s.Query(ctx, &Query{
+		Metric:     "req",
+		Start:      start,
+		End:        start.Add(6 * time.Minute),
+		Downsample: 2 * time.Minute,
+		Aggregator: Sum,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series) != 1 {
+		t.Fatalf("wanted 1 series, got %d", len(series))
+	}
+	want := []float64{0 + 1 + 10*(0+1), 2 + 3 + 10*(2+3), 4 + 5 + 10*(4+5)}
+	if got := series[0].Points; len(got) != len(want) {
+		t.Fatalf("got %v", got)
+	}
+	for i, p := range series[0].Points {
+		if p.Value != want[i] {
+			t.Errorf("point %d: got %v, wanted %v", i, p.Value, want[i])
+		}
+	}
+
+	series, err = s.Query(ctx, &Query{
+		Metric:     "req",
+		Tags:       map[string]string{"host": "*"},
+		GroupBy:    []string{"host"},
+		Start:      start,
+		End:        start.Add(6 * time.Minute),
+		Aggregator: Max,
+		Rate:       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series) != 2 {
+		t.Fatalf("wanted 2 series, got %d", len(series))
+	}
+	for _, sr := range series {
+		perSecond := 1.0 / 60
+		if sr.Tags["host"] == "b" {
+			perSecond *= 10
+		}
+		if len(sr.Points) != 5 {
+			t.Fatalf("%v: got %d points", sr.Tags, len(sr.Points))
+		}
+		for _, p := range sr.Points {
+			if d := p.Value - perSecond; d > 1e-9 || d < -1e-9 {
+				t.Errorf("%v: rate %v, wanted %v", sr.Tags, p.Value, perSecond)
+			}
+		}
+	}
+}
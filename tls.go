@@ -0,0 +1,189 @@
+package hbase
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+var DefaultTLSConnectTimeout = 5 * time.Second
+
+// TLSOptions configures TLS to a Thrift gateway with
+// hbase.thrift.ssl.enabled set.
+type TLSOptions struct {
+	// Config is the base configuration, it is cloned.
+	Config *tls.Config
+	// CAFile is a PEM bundle of the authorities verifying the gateway,
+	// replacing the system roots. It is loaded again when it changes on disk,
+	// and the gateway is then verified by VerifyConnection, against the
+	// ServerName of the configuration, or the name sent with SNI.
+	CAFile string
+	// CertFile and KeyFile are the PEM client certificate and key for mutual
+	// TLS. They are loaded again when either file changes on disk, so they can
+	// be rotated without a restart.
+	CertFile, KeyFile string
+	// ServerName is sent with SNI and verified instead of the dialed host.
+	ServerName string
+}
+
+// TLSConfig returns the tls.Config described by o. With a CAFile, a
+// connection to an IP address needs the ServerName set, as no name is sent
+// with SNI then.
+func (o *TLSOptions) TLSConfig() (*tls.Config, error) {
+	if o == nil {
+		o = &TLSOptions{}
+	}
+	cfg := &tls.Config{}
+	if o.Config != nil {
+		cfg = o.Config.Clone()
+	}
+	if cfg.MinVersion == 0 {
+		cfg.MinVersion = tls.VersionTLS12
+	}
+	if o.ServerName != "" {
+		cfg.ServerName = o.ServerName
+	}
+	if o.CAFile != "" {
+		r := &caReloader{file: o.CAFile}
+		roots, err := r.get()
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = roots
+		if !cfg.InsecureSkipVerify {
+			// tls verifies against RootCAs, which is not reloaded
+			cfg.InsecureSkipVerify = true
+			cfg.VerifyConnection = r.verify(cfg, cfg.VerifyConnection)
+		}
+	}
+	if o.CertFile != "" || o.KeyFile != "" {
+		r := &certReloader{certFile: o.CertFile, keyFile: o.KeyFile}
+		if _, err := r.get(); err != nil {
+			return nil, err
+		}
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.get()
+		}
+	}
+	return cfg, nil
+}
+
+// certReloader loads a key pair again when one of its files was modified. A
+// pair failing to load, e.g. while being rewritten, keeps the previous one.
+type certReloader struct {
+	certFile, keyFile string
+
+	mu              sync.Mutex
+	cert            *tls.Certificate
+	certMod, keyMod time.Time
+}
+
+func (r *certReloader) get() (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	certInfo, err := os.Stat(r.certFile)
+	if err == nil {
+		var keyInfo os.FileInfo
+		if keyInfo, err = os.Stat(r.keyFile); err == nil {
+			if r.cert != nil && certInfo.ModTime().Equal(r.certMod) && keyInfo.ModTime().Equal(r.keyMod) {
+				return r.cert, nil
+			}
+			var cert tls.Certificate
+			if cert, err = tls.LoadX509KeyPair(r.certFile, r.keyFile); err == nil {
+				r.cert, r.certMod, r.keyMod = &cert, certInfo.ModTime(), keyInfo.ModTime()
+			}
+		}
+	}
+	if r.cert != nil {
+		return r.cert, nil
+	}
+	return nil, err
+}
+
+// caReloader loads a CA bundle again when its file was modified. A bundle
+// failing to load keeps the previous one.
+type caReloader struct {
+	file string
+
+	mu    sync.Mutex
+	roots *x509.CertPool
+	mod   time.Time
+}
+
+func (r *caReloader) get() (*x509.CertPool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	info, err := os.Stat(r.file)
+	if err == nil {
+		if r.roots != nil && info.ModTime().Equal(r.mod) {
+			return r.roots, nil
+		}
+		var pem []byte
+		if pem, err = os.ReadFile(r.file); err == nil {
+			roots := x509.NewCertPool()
+			if roots.AppendCertsFromPEM(pem) {
+				r.roots, r.mod = roots, info.ModTime()
+			} else {
+				err = errors.New("hbase: no certificates in " + r.file)
+			}
+		}
+	}
+	if r.roots != nil {
+		return r.roots, nil
+	}
+	return nil, err
+}
+
+// verify returns a VerifyConnection verifying the gateway as tls does, with
+// the current roots, then calling next if set. The name verified is the
+// ServerName of cfg, else the one sent with SNI, which is none for an IP.
+func (r *caReloader) verify(cfg *tls.Config, next func(tls.ConnectionState) error) func(tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		roots, err := r.get()
+		if err != nil {
+			return err
+		}
+		name := cfg.ServerName
+		if name == "" {
+			name = cs.ServerName
+		}
+		if name == "" {
+			return errors.New("hbase: no ServerName to verify the gateway")
+		}
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("hbase: no gateway certificate")
+		}
+		opts := x509.VerifyOptions{Roots: roots, DNSName: name, Intermediates: x509.NewCertPool()}
+		for _, c := range cs.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(c)
+		}
+		if _, err := cs.PeerCertificates[0].Verify(opts); err != nil {
+			return err
+		}
+		if next != nil {
+			return next(cs)
+		}
+		return nil
+	}
+}
+
+// NewTLSSocket returns an unopened transport to host:port over TLS configured
+// by o. Connecting is bounded by DefaultTLSConnectTimeout.
+func NewTLSSocket(host, port string, o *TLSOptions) (*thrift.TSSLSocket, error) {
+	cfg, err := o.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.ServerName == "" {
+		cfg.ServerName = host
+	}
+	return thrift.NewTSSLSocketConf(net.JoinHostPort(host, port), &thrift.TConfiguration{
+		ConnectTimeout: DefaultTLSConnectTimeout,
+		TLSConfig:      cfg,
+	}), nil
+}
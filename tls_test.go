@@ -0,0 +1,190 @@
+package hbase_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/He11oLx/hbase/internal/testcert"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTLSServer(t *testing.T) (*testcert.Certs, string, string) {
+	certs, err := testcert.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := certs.ServerConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := memhbase.Listen(hbase.NewProcessor(memhbase.New()), ln, nil)
+	t.Cleanup(func() { l.Close() })
+	host, port, _ := net.SplitHostPort(l.Addr)
+	return certs, host, port
+}
+
+func TestNewTLSSocket(t *testing.T) {
+	ctx := context.Background()
+	certs, host, port := newTLSServer(t)
+
+	trans, err := hbase.NewTLSSocket(host, port, &hbase.TLSOptions{
+		CAFile:     certs.CAFile,
+		CertFile:   certs.ClientCertFile,
+		KeyFile:    certs.ClientKeyFile,
+		ServerName: "localhost",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := trans.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer trans.Close()
+	c := hbase.NewClientFactory(trans, thrift.NewTBinaryProtocolFactoryConf(nil))
+	cd := hbase.NewColumnDescriptor()
+	cd.Name = []byte("f:")
+	if err := c.CreateTable(ctx, []byte("t"), []*hbase.ColumnDescriptor{cd}); err != nil {
+		t.Fatal(err)
+	}
+	names, err := c.GetTableNames(ctx)
+	if err != nil || len(names) != 1 || string(names[0]) != "t" {
+		t.Fatalf("GetTableNames = %q, %v", names, err)
+	}
+
+	// the server requires a client certificate
+	trans, err = hbase.NewTLSSocket(host, port, &hbase.TLSOptions{CAFile: certs.CAFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := trans.Open(); err == nil {
+		defer trans.Close()
+		c = hbase.NewClientFactory(trans, thrift.NewTBinaryProtocolFactoryConf(nil))
+		if _, err := c.GetTableNames(ctx); err == nil {
+			t.Fatal("wanted an error without a client certificate")
+		}
+	}
+
+	// the system roots do not trust the test CA
+	trans, err = hbase.NewTLSSocket(host, port, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := trans.Open(); err == nil {
+		trans.Close()
+		t.Fatal("wanted an error verifying the server")
+	}
+}
+
+func TestTLSOptions_Reload(t *testing.T) {
+	certs, err := testcert.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := (&hbase.TLSOptions{CertFile: certs.ClientCertFile, KeyFile: certs.ClientKeyFile}).TLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := cfg.GetClientCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := cfg.GetClientCertificate(nil); again != first {
+		t.Fatal("unchanged files were loaded again")
+	}
+
+	if err := certs.IssueClient("rotated"); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	for _, f := range []string{certs.ClientCertFile, certs.ClientKeyFile} {
+		if err := os.Chtimes(f, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	second, err := cfg.GetClientCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(first.Certificate[0], second.Certificate[0]) {
+		t.Fatal("rotated certificate was not loaded")
+	}
+
+	// a broken pair keeps the last good one
+	if err := os.WriteFile(certs.ClientKeyFile, []byte("garbage"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if third, err := cfg.GetClientCertificate(nil); err != nil || third != second {
+		t.Fatalf("GetClientCertificate = %v, %v", third, err)
+	}
+}
+
+func TestTLSOptions_ReloadCA(t *testing.T) {
+	certs, host, port := newTLSServer(t)
+	other, err := testcert.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	copyFile := func(from string, mod time.Time) {
+		b, err := os.ReadFile(from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(caFile, b, 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(caFile, mod, mod); err != nil {
+			t.Fatal(err)
+		}
+	}
+	copyFile(other.CAFile, time.Now())
+	cfg, err := (&hbase.TLSOptions{
+		CAFile:     caFile,
+		CertFile:   certs.ClientCertFile,
+		KeyFile:    certs.ClientKeyFile,
+		ServerName: "localhost",
+	}).TLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dial := func() error {
+		conn, err := tls.Dial("tcp", net.JoinHostPort(host, port), cfg)
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}
+	if err := dial(); err == nil {
+		t.Fatal("wanted an error verifying the server with another CA")
+	}
+
+	copyFile(certs.CAFile, time.Now().Add(time.Minute))
+	if err := dial(); err != nil {
+		t.Fatalf("rotated CA was not loaded: %v", err)
+	}
+
+	// a broken bundle keeps the last good one
+	if err := os.WriteFile(caFile, []byte("garbage"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := dial(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the name is still verified
+	cfg.ServerName = "gateway.example.com"
+	if err := dial(); err == nil {
+		t.Fatal("wanted an error verifying the server name")
+	}
+}
@@ -0,0 +1,216 @@
+package unified
+
+import (
+	"bytes"
+	"context"
+	"github.com/He11oLx/hbase"
+	"sort"
+)
+
+type thrift1Client struct {
+	c hbase.Hbase
+}
+
+// NewThrift1 adapts a Thrift1 client. Thrift1 cannot express some requests:
+//   - Put writes cells with different timestamps in one call per timestamp,
+//     so such a put is not atomic.
+//   - Get with MaxVersions > 1 reads the row and then every column on its own.
+//   - CheckAndMutate supports a single put without a timestamp, otherwise it
+//     returns ErrUnsupported.
+func NewThrift1(c hbase.Hbase) Client {
+	return &thrift1Client{c: c}
+}
+
+func (p *thrift1Client) Backend() Backend {
+	return Thrift1
+}
+
+func (p *thrift1Client) Admin() Admin {
+	return p
+}
+
+func fromTRowResult(r *hbase.TRowResult_) *Result {
+	res := &Result{Row: r.Row}
+	for column, cell := range r.Columns {
+		family, qualifier := splitColumn([]byte(column))
+		res.Cells = append(res.Cells, &Cell{Family: family, Qualifier: qualifier, Value: cell.Value, Timestamp: cell.Timestamp})
+	}
+	for _, c := range r.SortedColumns {
+		family, qualifier := splitColumn(c.ColumnName)
+		res.Cells = append(res.Cells, &Cell{Family: family, Qualifier: qualifier, Value: c.Cell.Value, Timestamp: c.Cell.Timestamp})
+	}
+	sortCells(res.Cells)
+	return res
+}
+
+func sortCells(cells []*Cell) {
+	sort.SliceStable(cells, func(i, j int) bool {
+		// as the server orders them, the family "f" before "f1"
+		if c := bytes.Compare(cells[i].Family, cells[j].Family); c != 0 {
+			return c < 0
+		}
+		if c := bytes.Compare(cells[i].Qualifier, cells[j].Qualifier); c != 0 {
+			return c < 0
+		}
+		return cells[i].Timestamp > cells[j].Timestamp
+	})
+}
+
+func (p *thrift1Client) Get(ctx context.Context, table []byte, get *Get) (*Result, error) {
+	rows, err := p.c.GetRowWithColumns(ctx, table, get.Row, get.Columns, nil)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	res := fromTRowResult(rows[0])
+	if get.MaxVersions <= 1 {
+		return res, nil
+	}
+	cells := make([]*Cell, 0, len(res.Cells))
+	for _, latest := range res.Cells {
+		versions, err := p.c.GetVer(ctx, table, get.Row, latest.Column(), get.MaxVersions, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range versions {
+			cells = append(cells, &Cell{Family: latest.Family, Qualifier: latest.Qualifier, Value: v.Value, Timestamp: v.Timestamp})
+		}
+	}
+	res.Cells = cells
+	return res, nil
+}
+
+func (p *thrift1Client) Put(ctx context.Context, table []byte, row []byte, cells ...*Cell) error {
+	var order []int64
+	byTs := make(map[int64][]*hbase.Mutation)
+	for _, c := range cells {
+		if _, ok := byTs[c.Timestamp]; !ok {
+			order = append(order, c.Timestamp)
+		}
+		byTs[c.Timestamp] = append(byTs[c.Timestamp], &hbase.Mutation{Column: c.Column(), Value: c.Value, WriteToWAL: true})
+	}
+	for _, ts := range order {
+		var err error
+		if ts == 0 {
+			err = p.c.MutateRow(ctx, table, row, byTs[ts], nil)
+		} else {
+			err = p.c.MutateRowTs(ctx, table, row, byTs[ts], ts, nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *thrift1Client) Delete(ctx context.Context, table []byte, del *Delete) error {
+	if len(del.Columns) == 0 {
+		if del.Timestamp == 0 {
+			return p.c.DeleteAllRow(ctx, table, del.Row, nil)
+		}
+		return p.c.DeleteAllRowTs(ctx, table, del.Row, del.Timestamp, nil)
+	}
+	mutations := make([]*hbase.Mutation, 0, len(del.Columns))
+	for _, column := range del.Columns {
+		mutations = append(mutations, &hbase.Mutation{IsDelete: true, Column: column, WriteToWAL: true})
+	}
+	if del.Timestamp == 0 {
+		return p.c.MutateRow(ctx, table, del.Row, mutations, nil)
+	}
+	return p.c.MutateRowTs(ctx, table, del.Row, mutations, del.Timestamp, nil)
+}
+
+type thrift1Scanner struct {
+	s *hbase.Scanner
+}
+
+func (p *thrift1Client) Scan(table []byte, scan *Scan) Scanner {
+	ts := &hbase.TScan{
+		StartRow:     scan.StartRow,
+		StopRow:      scan.StopRow,
+		Columns:      scan.Columns,
+		FilterString: scan.Filter,
+	}
+	if scan.Reversed {
+		ts.Reversed = &scan.Reversed
+	}
+	if scan.Caching > 0 {
+		ts.Caching = &scan.Caching
+	}
+	return &thrift1Scanner{s: hbase.NewScanner(p.c, table, ts, nil)}
+}
+
+func (s *thrift1Scanner) Next(ctx context.Context) (*Result, error) {
+	r, err := s.s.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fromTRowResult(r), nil
+}
+
+func (s *thrift1Scanner) Close(ctx context.Context) error {
+	return s.s.Close(ctx)
+}
+
+func (p *thrift1Client) CheckAndMutate(ctx context.Context, table []byte, row []byte, column []byte, value []byte, m *RowMutations) (bool, error) {
+	if len(m.Deletes) > 0 || len(m.Puts) != 1 || m.Puts[0].Timestamp != 0 {
+		return false, ErrUnsupported
+	}
+	put := m.Puts[0]
+	return p.c.CheckAndPut(ctx, table, row, column, value, &hbase.Mutation{Column: put.Column(), Value: put.Value, WriteToWAL: true}, nil)
+}
+
+func (p *thrift1Client) Increment(ctx context.Context, table []byte, row []byte, column []byte, amount int64) (int64, error) {
+	return p.c.AtomicIncrement(ctx, table, row, column, amount)
+}
+
+func (p *thrift1Client) ListTables(ctx context.Context) ([][]byte, error) {
+	return p.c.GetTableNames(ctx)
+}
+
+func (p *thrift1Client) CreateTable(ctx context.Context, table []byte, families ...*Family) error {
+	cds := make([]*hbase.ColumnDescriptor, 0, len(families))
+	for _, f := range families {
+		cd := hbase.NewColumnDescriptor()
+		cd.Name = append(append([]byte{}, f.Name...), ':')
+		cd.MaxVersions = maxVersions(f)
+		if f.TimeToLive > 0 {
+			cd.TimeToLive = f.TimeToLive
+		}
+		cd.InMemory = f.InMemory
+		cds = append(cds, cd)
+	}
+	return p.c.CreateTable(ctx, table, cds)
+}
+
+func (p *thrift1Client) DeleteTable(ctx context.Context, table []byte) error {
+	return p.c.DeleteTable(ctx, table)
+}
+
+func (p *thrift1Client) EnableTable(ctx context.Context, table []byte) error {
+	return p.c.EnableTable(ctx, table)
+}
+
+func (p *thrift1Client) DisableTable(ctx context.Context, table []byte) error {
+	return p.c.DisableTable(ctx, table)
+}
+
+func (p *thrift1Client) IsTableEnabled(ctx context.Context, table []byte) (bool, error) {
+	return p.c.IsTableEnabled(ctx, table)
+}
+
+func (p *thrift1Client) Families(ctx context.Context, table []byte) ([]*Family, error) {
+	cds, err := p.c.GetColumnDescriptors(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	r := make([]*Family, 0, len(cds))
+	for _, cd := range cds {
+		f := &Family{Name: bytes.TrimSuffix(cd.Name, []byte(":")), MaxVersions: cd.MaxVersions, InMemory: cd.InMemory}
+		if cd.TimeToLive != forever {
+			f.TimeToLive = cd.TimeToLive
+		}
+		r = append(r, f)
+	}
+	sort.Slice(r, func(i, j int) bool { return bytes.Compare(r[i].Name, r[j].Name) < 0 })
+	return r, nil
+}
@@ -0,0 +1,323 @@
+package unified
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/thrift2"
+	"io"
+	"strings"
+)
+
+type thrift2Client struct {
+	c thrift2.THBaseService
+}
+
+// NewThrift2 adapts a Thrift2 client. Its scanners are opened again when the
+// gateway loses them, up to hbase.DefaultScannerMaxReopen times in a row, as
+// those of NewThrift1.
+func NewThrift2(c thrift2.THBaseService) Client {
+	return &thrift2Client{c: c}
+}
+
+func (p *thrift2Client) Backend() Backend {
+	return Thrift2
+}
+
+func (p *thrift2Client) Admin() Admin {
+	return p
+}
+
+func toTColumns(columns [][]byte) []*thrift2.TColumn {
+	if len(columns) == 0 {
+		return nil
+	}
+	r := make([]*thrift2.TColumn, 0, len(columns))
+	for _, column := range columns {
+		family, qualifier := splitColumn(column)
+		r = append(r, &thrift2.TColumn{Family: family, Qualifier: qualifier})
+	}
+	return r
+}
+
+func fromTResult(r *thrift2.TResult_) *Result {
+	if len(r.ColumnValues) == 0 {
+		return nil
+	}
+	res := &Result{Row: r.Row, Cells: make([]*Cell, 0, len(r.ColumnValues))}
+	for _, cv := range r.ColumnValues {
+		res.Cells = append(res.Cells, &Cell{Family: cv.Family, Qualifier: cv.Qualifier, Value: cv.Value, Timestamp: cv.GetTimestamp()})
+	}
+	return res
+}
+
+func (p *thrift2Client) Get(ctx context.Context, table []byte, get *Get) (*Result, error) {
+	tget := &thrift2.TGet{Row: get.Row, Columns: toTColumns(get.Columns)}
+	if get.MaxVersions > 1 {
+		tget.MaxVersions = &get.MaxVersions
+	}
+	r, err := p.c.Get(ctx, table, tget)
+	if err != nil {
+		return nil, err
+	}
+	return fromTResult(r), nil
+}
+
+func toTPut(row []byte, cells []*Cell) *thrift2.TPut {
+	put := &thrift2.TPut{Row: row, ColumnValues: make([]*thrift2.TColumnValue, 0, len(cells))}
+	for _, c := range cells {
+		cv := &thrift2.TColumnValue{Family: c.Family, Qualifier: c.Qualifier, Value: c.Value}
+		if cv.Qualifier == nil {
+			cv.Qualifier = []byte{}
+		}
+		if c.Timestamp != 0 {
+			ts := c.Timestamp
+			cv.Timestamp = &ts
+		}
+		put.ColumnValues = append(put.ColumnValues, cv)
+	}
+	return put
+}
+
+func (p *thrift2Client) Put(ctx context.Context, table []byte, row []byte, cells ...*Cell) error {
+	return p.c.Put(ctx, table, toTPut(row, cells))
+}
+
+func toTDelete(del *Delete) *thrift2.TDelete {
+	d := thrift2.NewTDelete()
+	d.Row = del.Row
+	d.Columns = toTColumns(del.Columns)
+	if del.Timestamp != 0 {
+		ts := del.Timestamp
+		d.Timestamp = &ts
+	}
+	return d
+}
+
+func (p *thrift2Client) Delete(ctx context.Context, table []byte, del *Delete) error {
+	return p.c.DeleteSingle(ctx, table, toTDelete(del))
+}
+
+// thrift2Scanner opens the scanner again after the last row fetched when
+// the server no longer knows it, as hbase.Scanner does for Thrift1.
+type thrift2Scanner struct {
+	c     thrift2.THBaseService
+	table []byte
+	scan  *thrift2.TScan
+
+	id     int32
+	opened bool
+	rows   []*thrift2.TResult_
+	done   bool
+	// last is the last row fetched, nil before the first
+	last    []byte
+	reopens int
+	// skip is a row dropped at the start of the scanner opened next
+	skip []byte
+}
+
+func (p *thrift2Client) Scan(table []byte, scan *Scan) Scanner {
+	ts := thrift2.NewTScan()
+	ts.StartRow, ts.StopRow = scan.StartRow, scan.StopRow
+	ts.Columns = toTColumns(scan.Columns)
+	ts.FilterString = scan.Filter
+	caching := scan.Caching
+	if caching <= 0 {
+		caching = hbase.DefaultScannerCaching
+	}
+	ts.Caching = &caching
+	if scan.Reversed {
+		ts.Reversed = &scan.Reversed
+	}
+	return &thrift2Scanner{c: p.c, table: table, scan: ts}
+}
+
+func (s *thrift2Scanner) Next(ctx context.Context) (*Result, error) {
+	for len(s.rows) == 0 {
+		if s.done {
+			return nil, io.EOF
+		}
+		if err := s.fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+	r := s.rows[0]
+	s.rows = s.rows[1:]
+	return fromTResult(r), nil
+}
+
+func (s *thrift2Scanner) fetch(ctx context.Context) (err error) {
+	if !s.opened {
+		if s.id, err = s.c.OpenScanner(ctx, s.table, s.resumeScan()); err != nil {
+			return err
+		}
+		s.opened = true
+	}
+	caching := *s.scan.Caching
+	rows, err := s.c.GetScannerRows(ctx, s.id, caching)
+	if _, ok := err.(*thrift2.TIllegalArgument); ok && s.reopens < hbase.DefaultScannerMaxReopen {
+		// the scanner is gone, after a gateway restart or a lease expiry
+		s.reopens++
+		s.opened = false
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.reopens = 0
+	exhausted := int32(len(rows)) < caching
+	if s.skip != nil && len(rows) > 0 && bytes.Equal(rows[0].Row, s.skip) {
+		rows = rows[1:]
+	}
+	s.skip = nil
+	if len(rows) > 0 {
+		s.last = rows[len(rows)-1].Row
+	}
+	s.rows = rows
+	if exhausted {
+		s.Close(ctx)
+	}
+	return nil
+}
+
+// resumeScan returns the scan of the rows after the last one fetched, from
+// the last row with a zero byte appended, or from the last row itself,
+// dropped again, for a reversed scan.
+func (s *thrift2Scanner) resumeScan() *thrift2.TScan {
+	if s.last == nil {
+		return s.scan
+	}
+	scan := *s.scan
+	if s.scan.Reversed != nil && *s.scan.Reversed {
+		scan.StartRow, s.skip = s.last, s.last
+	} else {
+		scan.StartRow = append(append(make([]byte, 0, len(s.last)+1), s.last...), 0)
+	}
+	return &scan
+}
+
+func (s *thrift2Scanner) Close(ctx context.Context) error {
+	if !s.opened {
+		return nil
+	}
+	s.opened = false
+	s.done = true
+	return s.c.CloseScanner(ctx, s.id)
+}
+
+func (p *thrift2Client) CheckAndMutate(ctx context.Context, table []byte, row []byte, column []byte, value []byte, m *RowMutations) (bool, error) {
+	rm := &thrift2.TRowMutations{Row: row}
+	if len(m.Deletes) > 0 {
+		rm.Mutations = append(rm.Mutations, &thrift2.TMutation{DeleteSingle: toTDelete(&Delete{Row: row, Columns: m.Deletes})})
+	}
+	if len(m.Puts) > 0 {
+		rm.Mutations = append(rm.Mutations, &thrift2.TMutation{Put: toTPut(row, m.Puts)})
+	}
+	family, qualifier := splitColumn(column)
+	if qualifier == nil {
+		qualifier = []byte{}
+	}
+	return p.c.CheckAndMutate(ctx, table, row, family, qualifier, thrift2.TCompareOp_EQUAL, value, rm)
+}
+
+func (p *thrift2Client) Increment(ctx context.Context, table []byte, row []byte, column []byte, amount int64) (int64, error) {
+	family, qualifier := splitColumn(column)
+	if qualifier == nil {
+		qualifier = []byte{}
+	}
+	returnResults := true
+	r, err := p.c.Increment(ctx, table, &thrift2.TIncrement{
+		Row:           row,
+		Columns:       []*thrift2.TColumnIncrement{{Family: family, Qualifier: qualifier, Amount: amount}},
+		ReturnResults: &returnResults,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(r.ColumnValues) != 1 || len(r.ColumnValues[0].Value) != 8 {
+		return 0, errors.New("unified: unexpected increment result")
+	}
+	return int64(binary.BigEndian.Uint64(r.ColumnValues[0].Value)), nil
+}
+
+func toTTableName(table []byte) *thrift2.TTableName {
+	family, qualifier := splitColumn(table)
+	if qualifier == nil {
+		return &thrift2.TTableName{Qualifier: table}
+	}
+	return &thrift2.TTableName{Ns: family, Qualifier: qualifier}
+}
+
+func (p *thrift2Client) ListTables(ctx context.Context) ([][]byte, error) {
+	namespaces, err := p.c.ListNamespaceDescriptors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var r [][]byte
+	for _, ns := range namespaces {
+		if ns.Name == "hbase" {
+			// hbase:meta and hbase:namespace, getTableNames of Thrift1
+			// lists none of them
+			continue
+		}
+		names, err := p.c.GetTableNamesByNamespace(ctx, ns.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if len(name.Ns) == 0 || string(name.Ns) == "default" {
+				r = append(r, name.Qualifier)
+			} else {
+				r = append(r, []byte(strings.Join([]string{string(name.Ns), string(name.Qualifier)}, ":")))
+			}
+		}
+	}
+	return r, nil
+}
+
+func (p *thrift2Client) CreateTable(ctx context.Context, table []byte, families ...*Family) error {
+	desc := &thrift2.TTableDescriptor{TableName: toTTableName(table)}
+	for _, f := range families {
+		versions := maxVersions(f)
+		cf := &thrift2.TColumnFamilyDescriptor{Name: f.Name, InMemory: &f.InMemory, MaxVersions: &versions}
+		if f.TimeToLive > 0 {
+			cf.TimeToLive = &f.TimeToLive
+		}
+		desc.Columns = append(desc.Columns, cf)
+	}
+	return p.c.CreateTable(ctx, desc, nil)
+}
+
+func (p *thrift2Client) DeleteTable(ctx context.Context, table []byte) error {
+	return p.c.DeleteTable(ctx, toTTableName(table))
+}
+
+func (p *thrift2Client) EnableTable(ctx context.Context, table []byte) error {
+	return p.c.EnableTable(ctx, toTTableName(table))
+}
+
+func (p *thrift2Client) DisableTable(ctx context.Context, table []byte) error {
+	return p.c.DisableTable(ctx, toTTableName(table))
+}
+
+func (p *thrift2Client) IsTableEnabled(ctx context.Context, table []byte) (bool, error) {
+	return p.c.IsTableEnabled(ctx, toTTableName(table))
+}
+
+func (p *thrift2Client) Families(ctx context.Context, table []byte) ([]*Family, error) {
+	desc, err := p.c.GetTableDescriptor(ctx, toTTableName(table))
+	if err != nil {
+		return nil, err
+	}
+	r := make([]*Family, 0, len(desc.Columns))
+	for _, cf := range desc.Columns {
+		f := &Family{Name: cf.Name, MaxVersions: cf.GetMaxVersions(), InMemory: cf.GetInMemory()}
+		if ttl := cf.GetTimeToLive(); ttl != forever {
+			f.TimeToLive = ttl
+		}
+		r = append(r, f)
+	}
+	return r, nil
+}
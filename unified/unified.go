@@ -0,0 +1,191 @@
+// Package unified is a backend neutral API over the Thrift1 (hbase.Hbase) and
+// Thrift2 (thrift2.THBaseService) gateways, so application code does not
+// change when a deployment moves from one gateway to the other.
+//
+// Columns are written "family:qualifier" or "family" as in Thrift1, and
+// timestamps are milliseconds since the epoch.
+package unified
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/thrift2"
+	"github.com/apache/thrift/lib/go/thrift"
+	"math"
+)
+
+// ErrUnsupported is returned for requests the backend cannot express.
+var ErrUnsupported = errors.New("unified: not supported by the backend")
+
+// forever is the TimeToLive servers report for cells that never expire.
+const forever = math.MaxInt32
+
+// Backend is the gateway a Client talks to.
+type Backend int
+
+const (
+	Thrift1 Backend = iota + 1
+	Thrift2
+)
+
+func (b Backend) String() string {
+	switch b {
+	case Thrift1:
+		return "thrift1"
+	case Thrift2:
+		return "thrift2"
+	}
+	return fmt.Sprintf("Backend(%d)", int(b))
+}
+
+// DefaultMaxVersions is the number of versions CreateTable keeps for a
+// Family with MaxVersions 0, the default of HBase.
+var DefaultMaxVersions int32 = 1
+
+// Cell is a version of a column of a row.
+type Cell struct {
+	Family, Qualifier, Value []byte
+	// 0 lets the server assign the time on writes
+	Timestamp int64
+}
+
+// Column returns the cell's "family:qualifier".
+func (c *Cell) Column() []byte {
+	return bytes.Join([][]byte{c.Family, c.Qualifier}, []byte(":"))
+}
+
+// Result is a row. Cells are sorted by column, newest version first.
+type Result struct {
+	Row   []byte
+	Cells []*Cell
+}
+
+// Value returns the newest value of column, or nil.
+func (r *Result) Value(column []byte) []byte {
+	if r == nil {
+		return nil
+	}
+	family, qualifier := splitColumn(column)
+	for _, c := range r.Cells {
+		if bytes.Equal(c.Family, family) && bytes.Equal(c.Qualifier, qualifier) {
+			return c.Value
+		}
+	}
+	return nil
+}
+
+// Get selects the columns and versions of a row to read.
+type Get struct {
+	Row []byte
+	// all columns if empty
+	Columns [][]byte
+	// versions per column, 1 if <= 0
+	MaxVersions int32
+}
+
+// Delete selects the columns and versions of a row to delete.
+type Delete struct {
+	Row []byte
+	// the whole row if empty
+	Columns [][]byte
+	// deletes the versions up to and including Timestamp, all if 0
+	Timestamp int64
+}
+
+// RowMutations are applied atomically by CheckAndMutate, deletes first.
+type RowMutations struct {
+	Puts []*Cell
+	// columns whose versions are all deleted
+	Deletes [][]byte
+}
+
+// Scan selects a range of rows to read with Client.Scan.
+type Scan struct {
+	StartRow, StopRow []byte
+	Columns           [][]byte
+	// filter language, e.g. "PrefixFilter('a')"
+	Filter []byte
+	// scans from StartRow down to StopRow (exclusive)
+	Reversed bool
+	// rows per round trip, hbase.DefaultScannerCaching if <= 0
+	Caching int32
+}
+
+// Scanner returns the rows of a Scan. Next returns io.EOF at the end.
+type Scanner interface {
+	Next(ctx context.Context) (*Result, error)
+	Close(ctx context.Context) error
+}
+
+// Family is a column family of a table.
+type Family struct {
+	Name []byte
+	// versions kept per column, DefaultMaxVersions if 0
+	MaxVersions int32
+	// seconds, 0 means forever
+	TimeToLive int32
+	InMemory   bool
+}
+
+// Admin manages the tables of the gateway.
+type Admin interface {
+	// ListTables returns the user tables of every namespace, as "ns:table"
+	// outside the default namespace, without the system tables of the hbase
+	// namespace.
+	ListTables(ctx context.Context) ([][]byte, error)
+	CreateTable(ctx context.Context, table []byte, families ...*Family) error
+	// DeleteTable deletes a disabled table.
+	DeleteTable(ctx context.Context, table []byte) error
+	EnableTable(ctx context.Context, table []byte) error
+	DisableTable(ctx context.Context, table []byte) error
+	IsTableEnabled(ctx context.Context, table []byte) (bool, error)
+	Families(ctx context.Context, table []byte) ([]*Family, error)
+}
+
+// Client reads and writes rows through one of the gateways, New or the
+// NewThrift1 and NewThrift2 adapters return one for a backend.
+type Client interface {
+	Backend() Backend
+	// Get returns nil if the row has none of the requested columns.
+	Get(ctx context.Context, table []byte, get *Get) (*Result, error)
+	Put(ctx context.Context, table []byte, row []byte, cells ...*Cell) error
+	Delete(ctx context.Context, table []byte, del *Delete) error
+	Scan(table []byte, scan *Scan) Scanner
+	// CheckAndMutate applies m if the newest value of column equals value. An
+	// empty value matches a missing (or empty) cell.
+	CheckAndMutate(ctx context.Context, table []byte, row []byte, column []byte, value []byte, m *RowMutations) (bool, error)
+	// Increment adds amount to an 8 byte big-endian counter and returns the
+	// new value.
+	Increment(ctx context.Context, table []byte, row []byte, column []byte, amount int64) (int64, error)
+	Admin() Admin
+}
+
+// New returns the Client for backend over c, e.g. a pool.TPoolClient.
+func New(backend Backend, c thrift.TClient) (Client, error) {
+	switch backend {
+	case Thrift1:
+		return NewThrift1(hbase.NewClient(c)), nil
+	case Thrift2:
+		return NewThrift2(thrift2.NewClient(c)), nil
+	}
+	return nil, fmt.Errorf("unified: unknown backend %v", backend)
+}
+
+// maxVersions returns the versions CreateTable keeps for f, the default of
+// each gateway differs.
+func maxVersions(f *Family) int32 {
+	if f.MaxVersions > 0 {
+		return f.MaxVersions
+	}
+	return DefaultMaxVersions
+}
+
+func splitColumn(column []byte) (family, qualifier []byte) {
+	if i := bytes.IndexByte(column, ':'); i >= 0 {
+		return column[:i], column[i+1:]
+	}
+	return column, nil
+}
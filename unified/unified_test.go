@@ -0,0 +1,233 @@
+package unified_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"github.com/He11oLx/hbase/unified"
+	"io"
+	"strings"
+	"testing"
+)
+
+func backends() map[string]unified.Client {
+	s := memhbase.New()
+	return map[string]unified.Client{
+		"thrift1": unified.NewThrift1(s.Client()),
+		"thrift2": unified.NewThrift2(s.Thrift2Client()),
+	}
+}
+
+func TestClient(t *testing.T) {
+	for name, c := range backends() {
+		t.Run(name, func(t *testing.T) { testClient(t, c) })
+	}
+}
+
+func testClient(t *testing.T, c unified.Client) {
+	ctx := context.Background()
+	table := []byte("t_" + c.Backend().String())
+	admin := c.Admin()
+	if err := admin.CreateTable(ctx, table, &unified.Family{Name: []byte("f"), MaxVersions: 3}); err != nil {
+		t.Fatal(err)
+	}
+	families, err := admin.Families(ctx, table)
+	if err != nil || len(families) != 1 || string(families[0].Name) != "f" || families[0].MaxVersions != 3 || families[0].TimeToLive != 0 {
+		t.Fatalf("Families = %v, %v", families, err)
+	}
+
+	row := []byte("r1")
+	if err := c.Put(ctx, table, row,
+		&unified.Cell{Family: []byte("f"), Qualifier: []byte("a"), Value: []byte("1"), Timestamp: 10},
+		&unified.Cell{Family: []byte("f"), Qualifier: []byte("b"), Value: []byte("x")},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Put(ctx, table, row, &unified.Cell{Family: []byte("f"), Qualifier: []byte("a"), Value: []byte("2"), Timestamp: 20}); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := c.Get(ctx, table, &unified.Get{Row: row})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Cells) != 2 || string(r.Value([]byte("f:a"))) != "2" || string(r.Value([]byte("f:b"))) != "x" {
+		t.Fatalf("Get = %v", r)
+	}
+	r, err = c.Get(ctx, table, &unified.Get{Row: row, Columns: [][]byte{[]byte("f:a")}, MaxVersions: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Cells) != 2 || r.Cells[0].Timestamp != 20 || r.Cells[1].Timestamp != 10 {
+		t.Fatalf("versions %v", r.Cells)
+	}
+	if r, err = c.Get(ctx, table, &unified.Get{Row: []byte("missing")}); err != nil || r != nil {
+		t.Fatalf("missing row = %v, %v", r, err)
+	}
+
+	ok, err := c.CheckAndMutate(ctx, table, row, []byte("f:b"), []byte("y"), &unified.RowMutations{Puts: []*unified.Cell{{Family: []byte("f"), Qualifier: []byte("b"), Value: []byte("z")}}})
+	if err != nil || ok {
+		t.Fatalf("mismatched CheckAndMutate = %v, %v", ok, err)
+	}
+	ok, err = c.CheckAndMutate(ctx, table, row, []byte("f:b"), []byte("x"), &unified.RowMutations{Puts: []*unified.Cell{{Family: []byte("f"), Qualifier: []byte("b"), Value: []byte("z")}}})
+	if err != nil || !ok {
+		t.Fatalf("CheckAndMutate = %v, %v", ok, err)
+	}
+	deletes := &unified.RowMutations{Deletes: [][]byte{[]byte("f:a")}, Puts: []*unified.Cell{{Family: []byte("f"), Qualifier: []byte("c"), Value: []byte("c")}}}
+	ok, err = c.CheckAndMutate(ctx, table, row, []byte("f:b"), []byte("z"), deletes)
+	switch c.Backend() {
+	case unified.Thrift1:
+		if err != unified.ErrUnsupported {
+			t.Fatalf("wanted ErrUnsupported, got %v", err)
+		}
+	case unified.Thrift2:
+		if err != nil || !ok {
+			t.Fatalf("CheckAndMutate with deletes = %v, %v", ok, err)
+		}
+		if r, _ := c.Get(ctx, table, &unified.Get{Row: row}); r.Value([]byte("f:a")) != nil || string(r.Value([]byte("f:c"))) != "c" {
+			t.Fatalf("after CheckAndMutate %v", r)
+		}
+	}
+
+	for i, want := range []int64{5, 3} {
+		n, err := c.Increment(ctx, table, []byte("counter"), []byte("f:n"), []int64{5, -2}[i])
+		if err != nil || n != want {
+			t.Fatalf("Increment = %d, %v, want %d", n, err, want)
+		}
+	}
+
+	if err := c.Put(ctx, table, []byte("r2"), &unified.Cell{Family: []byte("f"), Qualifier: []byte("a"), Value: []byte("v")}); err != nil {
+		t.Fatal(err)
+	}
+	var rows [][]byte
+	s := c.Scan(table, &unified.Scan{StartRow: []byte("r"), Caching: 1})
+	for {
+		r, err := s.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, r.Row)
+	}
+	if err := s.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bytes.Join(rows, []byte(",")), []byte("r1,r2")) {
+		t.Fatalf("scanned %q", rows)
+	}
+
+	if err := c.Delete(ctx, table, &unified.Delete{Row: row, Columns: [][]byte{[]byte("f")}}); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := c.Get(ctx, table, &unified.Get{Row: row}); err != nil || r != nil {
+		t.Fatalf("deleted row = %v, %v", r, err)
+	}
+
+	if err := admin.DisableTable(ctx, table); err != nil {
+		t.Fatal(err)
+	}
+	if enabled, err := admin.IsTableEnabled(ctx, table); err != nil || enabled {
+		t.Fatalf("IsTableEnabled = %v, %v", enabled, err)
+	}
+	if err := admin.DeleteTable(ctx, table); err != nil {
+		t.Fatal(err)
+	}
+	tables, err := admin.ListTables(ctx)
+	if err != nil || len(tables) != 0 {
+		t.Fatalf("ListTables = %q, %v", tables, err)
+	}
+}
+
+func TestClient_Consistency(t *testing.T) {
+	ctx := context.Background()
+	s := memhbase.New()
+	for name, c := range map[string]unified.Client{
+		"thrift1": unified.NewThrift1(s.Client()),
+		"thrift2": unified.NewThrift2(s.Thrift2Client()),
+	} {
+		table := []byte("c_" + name)
+		if err := c.Admin().CreateTable(ctx, table, &unified.Family{Name: []byte("f")}, &unified.Family{Name: []byte("f1")}); err != nil {
+			t.Fatal(err)
+		}
+		// both backends share s, system tables are not listed
+		tables, err := c.Admin().ListTables(ctx)
+		listed, system := false, false
+		for _, tb := range tables {
+			listed = listed || bytes.Equal(tb, table)
+			system = system || bytes.HasPrefix(tb, []byte("hbase:"))
+		}
+		if err != nil || !listed || system {
+			t.Fatalf("%s: ListTables = %q, %v", name, tables, err)
+		}
+		families, err := c.Admin().Families(ctx, table)
+		if err != nil || len(families) != 2 || families[0].MaxVersions != unified.DefaultMaxVersions || families[1].MaxVersions != unified.DefaultMaxVersions {
+			t.Fatalf("%s: Families = %v, %v", name, families, err)
+		}
+
+		var cells []*unified.Cell
+		for _, col := range []string{"f1:a", "f:b", "f:a"} {
+			f, q, _ := strings.Cut(col, ":")
+			cells = append(cells, &unified.Cell{Family: []byte(f), Qualifier: []byte(q), Value: []byte(col)})
+		}
+		if err := c.Put(ctx, table, []byte("r"), cells...); err != nil {
+			t.Fatal(err)
+		}
+		r, err := c.Get(ctx, table, &unified.Get{Row: []byte("r")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, cell := range r.Cells {
+			got = append(got, string(cell.Column()))
+		}
+		if strings.Join(got, ",") != "f:a,f:b,f1:a" {
+			t.Fatalf("%s: cells %q, wanted family then qualifier order", name, got)
+		}
+	}
+}
+
+func TestScanner_Reopen(t *testing.T) {
+	ctx := context.Background()
+	s := memhbase.New()
+	for name, c := range map[string]unified.Client{
+		"thrift1": unified.NewThrift1(s.Client()),
+		"thrift2": unified.NewThrift2(s.Thrift2Client()),
+	} {
+		table := []byte("s_" + name)
+		if err := c.Admin().CreateTable(ctx, table, &unified.Family{Name: []byte("f")}); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 7; i++ {
+			if err := c.Put(ctx, table, []byte{'r', byte('0' + i)}, &unified.Cell{Family: []byte("f"), Value: []byte("v")}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, reversed := range []bool{false, true} {
+			sc := c.Scan(table, &unified.Scan{Caching: 2, Reversed: reversed})
+			var rows []string
+			for {
+				r, err := sc.Next(ctx)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("%s reversed %v: %v", name, reversed, err)
+				}
+				rows = append(rows, string(r.Row))
+				if len(rows)%3 == 0 {
+					s.ExpireScanners()
+				}
+			}
+			sc.Close(ctx)
+			want := "r0,r1,r2,r3,r4,r5,r6"
+			if reversed {
+				want = "r6,r5,r4,r3,r2,r1,r0"
+			}
+			if strings.Join(rows, ",") != want {
+				t.Fatalf("%s reversed %v: rows %q", name, reversed, rows)
+			}
+		}
+	}
+}
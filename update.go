@@ -0,0 +1,171 @@
+package hbase
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+var ErrConflict = errors.New("hbase: row update kept conflicting, giving up")
+
+// UpdateOptions controls UpdateRow.
+//
+// VersionColumn holds the row's version and must belong to an existing
+// family. A writer first moves it from the version it read to a pending
+// marker with CheckAndPut, then applies its mutations together with the new
+// version in one MutateRow, which HBase applies atomically. Thrift1 cannot
+// make the mutations themselves conditional, so a pending marker older than
+// PendingTimeout is assumed to belong to a crashed writer and is taken over.
+//
+// Zero fields, but MaxRetries, are taken from DefaultUpdateOptions.
+type UpdateOptions struct {
+	VersionColumn []byte
+	// MaxRetries is the number of retries after a conflict, 0 for none.
+	MaxRetries     int
+	Backoff        time.Duration
+	MaxBackoff     time.Duration
+	PendingTimeout time.Duration
+}
+
+var DefaultUpdateOptions = UpdateOptions{
+	VersionColumn:  []byte("v:version"),
+	MaxRetries:     10,
+	Backoff:        10 * time.Millisecond,
+	MaxBackoff:     time.Second,
+	PendingTimeout: 30 * time.Second,
+}
+
+// UpdateRow is UpdateRowWithOptions using DefaultUpdateOptions.
+func (p *Client) UpdateRow(ctx context.Context, tableName []byte, row []byte, fn func(current *TRowResult_) ([]*Mutation, error)) error {
+	return UpdateRow(ctx, p, tableName, row, &DefaultUpdateOptions, fn)
+}
+
+// UpdateRowWithOptions reads the row, passes it to fn (nil if the row does
+// not exist) and commits the returned mutations only if nobody else updated
+// the row in between, retrying with exponential backoff on conflict. If fn
+// returns an error or no mutations nothing is written. A nil opts is
+// DefaultUpdateOptions.
+func (p *Client) UpdateRowWithOptions(ctx context.Context, tableName []byte, row []byte, opts *UpdateOptions, fn func(current *TRowResult_) ([]*Mutation, error)) error {
+	return UpdateRow(ctx, p, tableName, row, opts, fn)
+}
+
+// withDefaults returns o with its zero fields set from DefaultUpdateOptions,
+// DefaultUpdateOptions if o is nil.
+func (o *UpdateOptions) withDefaults() *UpdateOptions {
+	d := DefaultUpdateOptions
+	if o == nil {
+		return &d
+	}
+	opts := *o
+	if opts.VersionColumn == nil {
+		opts.VersionColumn = d.VersionColumn
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = d.Backoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = d.MaxBackoff
+	}
+	if opts.PendingTimeout <= 0 {
+		opts.PendingTimeout = d.PendingTimeout
+	}
+	return &opts
+}
+
+// UpdateRow implements Client.UpdateRow for any Hbase. A nil opts is
+// DefaultUpdateOptions.
+func UpdateRow(ctx context.Context, c Hbase, tableName []byte, row []byte, opts *UpdateOptions, fn func(current *TRowResult_) ([]*Mutation, error)) error {
+	opts = opts.withDefaults()
+	backoff := opts.Backoff
+	for i := 0; ; i++ {
+		err := updateRow(ctx, c, tableName, row, opts, fn)
+		if err != ErrConflict {
+			return err
+		}
+		if i >= opts.MaxRetries {
+			return ErrConflict
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))):
+		}
+		if backoff *= 2; backoff > opts.MaxBackoff {
+			backoff = opts.MaxBackoff
+		}
+	}
+}
+
+// The version cell is an 8 byte counter, followed by an 8 byte deadline in
+// unix milliseconds while a writer is between claim and commit.
+func encodeVersion(version int64, deadline int64) []byte {
+	b := make([]byte, 8, 16)
+	binary.BigEndian.PutUint64(b, uint64(version))
+	if deadline > 0 {
+		b = b[:16]
+		binary.BigEndian.PutUint64(b[8:], uint64(deadline))
+	}
+	return b
+}
+
+func decodeVersion(b []byte) (version int64, deadline int64) {
+	if len(b) >= 8 {
+		version = int64(binary.BigEndian.Uint64(b))
+	}
+	if len(b) >= 16 {
+		deadline = int64(binary.BigEndian.Uint64(b[8:]))
+	}
+	return
+}
+
+func updateRow(ctx context.Context, c Hbase, tableName []byte, row []byte, opts *UpdateOptions, fn func(current *TRowResult_) ([]*Mutation, error)) error {
+	rows, err := c.GetRow(ctx, tableName, row, nil)
+	if err != nil {
+		return err
+	}
+	var current *TRowResult_
+	var expected []byte
+	if len(rows) > 0 {
+		current = rows[0]
+		if cell, ok := current.Columns[string(opts.VersionColumn)]; ok {
+			expected = cell.Value
+		}
+	}
+	version, deadline := decodeVersion(expected)
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	if deadline > now {
+		return ErrConflict
+	}
+
+	mutations, err := fn(current)
+	if err != nil || len(mutations) == 0 {
+		return err
+	}
+
+	pending := encodeVersion(version+1, now+int64(opts.PendingTimeout/time.Millisecond))
+	claim := NewMutation()
+	claim.Column, claim.Value = opts.VersionColumn, pending
+	ok, err := c.CheckAndPut(ctx, tableName, row, opts.VersionColumn, expected, claim, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrConflict
+	}
+
+	commit := NewMutation()
+	commit.Column, commit.Value = opts.VersionColumn, encodeVersion(version+1, 0)
+	if err = c.MutateRow(ctx, tableName, row, append(mutations[:len(mutations):len(mutations)], commit), nil); err != nil {
+		// best effort, otherwise the row stays pending until PendingTimeout
+		undo := NewMutation()
+		undo.Column, undo.Value = opts.VersionColumn, expected
+		if undo.Value == nil {
+			undo.Value = []byte{}
+		}
+		c.CheckAndPut(ctx, tableName, row, opts.VersionColumn, pending, undo, nil)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package hbase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/He11oLx/hbase"
+	"github.com/He11oLx/hbase/internal/memhbase"
+	"sync"
+	"testing"
+)
+
+func TestClient_UpdateRow(t *testing.T) {
+	ctx := context.Background()
+	c := memhbase.NewTable(t, "docs", "d", "v").Client()
+	table, row := []byte("docs"), []byte("doc1")
+
+	type doc struct{ Count int }
+	incr := func(current *hbase.TRowResult_) ([]*hbase.Mutation, error) {
+		var d doc
+		if current != nil {
+			if err := json.Unmarshal(current.Columns["d:json"].Value, &d); err != nil {
+				return nil, err
+			}
+		}
+		d.Count++
+		b, _ := json.Marshal(d)
+		m := hbase.NewMutation()
+		m.Column, m.Value = []byte("d:json"), b
+		return []*hbase.Mutation{m}, nil
+	}
+
+	opts := hbase.DefaultUpdateOptions
+	opts.MaxRetries = 1000
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- c.UpdateRowWithOptions(ctx, table, row, &opts, incr)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := c.GetRow(ctx, table, row, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d doc
+	if err = json.Unmarshal(rows[0].Columns["d:json"].Value, &d); err != nil {
+		t.Fatal(err)
+	}
+	if d.Count != 20 {
+		t.Fatalf("lost updates: count %d, wanted 20", d.Count)
+	}
+
+	// nil options are the defaults, zero fields of options too
+	for i, opts := range []*hbase.UpdateOptions{nil, {MaxRetries: 1}} {
+		row := []byte(fmt.Sprintf("doc%d", i+2))
+		if err = hbase.UpdateRow(ctx, c, table, row, opts, incr); err != nil {
+			t.Fatal(err)
+		}
+		rows, err := c.GetRow(ctx, table, row, nil)
+		if err != nil || len(rows) != 1 || rows[0].Columns["v:version"] == nil {
+			t.Fatalf("options %+v: row %v, %v", opts, rows, err)
+		}
+	}
+
+	boom := errors.New("boom")
+	if err = c.UpdateRow(ctx, table, row, func(*hbase.TRowResult_) ([]*hbase.Mutation, error) { return nil, boom }); err != boom {
+		t.Fatalf("wanted fn error, got %v", err)
+	}
+}